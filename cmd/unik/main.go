@@ -0,0 +1,94 @@
+// Command unik exports and imports the locale/region data bundle used by the
+// timestamp packages.
+//
+// Usage:
+//
+//	unik export [-o bundle.json]   write every locale and region pattern as JSON
+//	unik import bundle.json        validate a bundle and print what it contains
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/Roisfaozi/unik/timestamp/bundle"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:], os.Stdout)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unik:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: unik export [-o file] | unik import <file>")
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	out := fs.String("o", "", "output file (default stdout)")
+	fs.Parse(args)
+
+	if *out == "" {
+		return bundle.Write(os.Stdout, bundle.Export())
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Write(f, bundle.Export()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func runImport(args []string, w io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("import needs exactly one file")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	b, err := bundle.Read(f)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Import(b); err != nil {
+		return err
+	}
+
+	codes := make([]string, 0, len(b.Locales))
+	for code := range b.Locales {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	fmt.Fprintf(w, "bundle v%d: %d locales, %d regions\n", b.Version, len(b.Locales), len(b.Regions))
+	for _, code := range codes {
+		loc := b.Locales[code]
+		fmt.Fprintf(w, "  %-6s %3d strings, %d units\n", code, len(loc.Dictionary), len(loc.Plurals))
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+// Package bundle serializes every registered smart locale and regional pattern
+// into one JSON document, so web and mobile clients render the same labels as
+// the Go server, and loads such a document back into the smart and regional
+// registries.
+//
+// Bundle format (version 1):
+//
+//		{
+//		  "version": 1,
+//		  "locales": {
+//		    "de": {
+//		      "pluralRules": {"one": "i = 1 and v = 0"},
+//		      "dictionary": {"just_now": "gerade eben", "past": "vor {0}", "month_1": "Januar", "pattern_date_long": "d. MMMM y"},
+//		      "plurals": {"day": {"one": "Tag", "other": "Tage"}},
+//		      "forms": {"day": {"past": {"other": "Tagen"}}},
+//		      "registers": {"casual": {"dictionary": {"just_now": "eben"}}}
+//		    }
+//		  },
+//		  "regions": {"us": {"pattern": "MM/dd/yyyy hh:mm a", "calendar": "gregorian", "locale": "en"}}
+//		}
+//
+//	  - pluralRules: CLDR plural rule syntax per category (zero, one, two, few, many);
+//	    any count matching none of them is "other".
+//	  - dictionary: static strings. "{0}" stands for the number ("past", "future",
+//	    short units); keys prefixed "pattern_" hold LDML date/time patterns.
+//	  - plurals: unit words ("sec", "min", "hour", "day", "year") per plural category.
+//	    A value is a bare word ("Tage") or a phrase with "{0}" ("{0} Tage").
+//	  - forms: overrides of plurals per grammatical context (standalone, past, future).
+//	  - registers: casual/formal overrides of dictionary and plurals.
+//	  - regions: the default output of regional.Format per region as an LDML pattern,
+//	    with the CLDR name of its calendar and the smart locale of its names.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Roisfaozi/unik/timestamp/regional"
+	"github.com/Roisfaozi/unik/timestamp/smart"
+)
+
+// Version is the bundle format version written by Export.
+const Version = 1
+
+// Bundle is the JSON document shared between the Go server and other clients.
+type Bundle struct {
+	Version int               `json:"version"`
+	Locales map[string]Locale `json:"locales"`
+	Regions map[string]Region `json:"regions,omitempty"`
+}
+
+// Locale is the JSON form of smart.Locale.
+type Locale struct {
+	PluralRules map[string]string                       `json:"pluralRules,omitempty"`
+	Dictionary  map[string]string                       `json:"dictionary,omitempty"`
+	Plurals     map[string]map[string]string            `json:"plurals,omitempty"`
+	Forms       map[string]map[string]map[string]string `json:"forms,omitempty"`
+	Registers   map[string]Variant                      `json:"registers,omitempty"`
+}
+
+// Variant is the JSON form of smart.LocaleVariant.
+type Variant struct {
+	Dictionary map[string]string            `json:"dictionary,omitempty"`
+	Plurals    map[string]map[string]string `json:"plurals,omitempty"`
+}
+
+// Region is the JSON form of regional.RegionPattern.
+type Region struct {
+	Pattern  string `json:"pattern"`
+	Calendar string `json:"calendar"`
+	Locale   string `json:"locale,omitempty"`
+}
+
+// Export collects every registered smart locale and regional pattern.
+// Locales whose plural rule only exists as Go code (no PluralRules source)
+// are exported without rules and behave as "other only" when imported.
+func Export() Bundle {
+	b := Bundle{
+		Version: Version,
+		Locales: map[string]Locale{},
+		Regions: map[string]Region{},
+	}
+	for _, loc := range smart.Locales() {
+		b.Locales[loc.Code] = fromLocale(loc)
+	}
+	for region, p := range regional.Patterns() {
+		b.Regions[string(region)] = Region{Pattern: p.Pattern, Calendar: p.Calendar, Locale: p.Locale}
+	}
+	return b
+}
+
+// Import registers every locale of b with smart.RegisterLocale and every
+// region with regional.RegisterRegion, replacing those with the same code. A
+// region that is already registered keeps its other settings (hour cycle,
+// style patterns) and, when the calendar name is unchanged, its calendar; a
+// new calendar name selects the built-in calendar of regional.CalendarNamed.
+func Import(b Bundle) error {
+	if b.Version != Version {
+		return fmt.Errorf("unsupported bundle version %d (want %d)", b.Version, Version)
+	}
+
+	// Convert and validate everything first so a bad bundle doesn't leave the
+	// registries half-updated
+	locales := make([]smart.Locale, 0, len(b.Locales))
+	for code, loc := range b.Locales {
+		converted, err := loc.toLocale(code)
+		if err != nil {
+			return fmt.Errorf("locale %s: %w", code, err)
+		}
+		locales = append(locales, converted)
+	}
+	registered := regional.Patterns()
+	specs := make(map[regional.Region]regional.RegionSpec, len(b.Regions))
+	for code, r := range b.Regions {
+		region := regional.Region(code)
+		spec, err := r.toRegionSpec(region, registered)
+		if err == nil {
+			err = regional.ValidateRegion(region, spec)
+		}
+		if err != nil {
+			return err
+		}
+		specs[region] = spec
+	}
+
+	for _, loc := range locales {
+		if err := smart.RegisterLocale(loc); err != nil {
+			return err
+		}
+	}
+	for region, spec := range specs {
+		if err := regional.RegisterRegion(region, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toRegionSpec merges r into the registered spec of region, if any.
+// registered holds the patterns of the regions registered before the import.
+func (r Region) toRegionSpec(region regional.Region, registered map[regional.Region]regional.RegionPattern) (regional.RegionSpec, error) {
+	spec, _ := regional.LookupRegion(region)
+	spec.Pattern = r.Pattern
+	if r.Locale != "" {
+		spec.Locale = r.Locale
+	}
+
+	calendar := r.Calendar
+	if calendar == "" {
+		calendar = "gregorian"
+	}
+	if p, ok := registered[region]; !ok || p.Calendar != calendar {
+		cal, ok := regional.CalendarNamed(calendar)
+		if !ok {
+			return spec, fmt.Errorf("region %s: unknown calendar %q", region, r.Calendar)
+		}
+		spec.Calendar = cal
+	}
+	return spec, nil
+}
+
+// Write encodes b as indented JSON.
+func Write(w io.Writer, b Bundle) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(b)
+}
+
+// Read decodes a bundle written by Write (or by another client).
+func Read(r io.Reader) (Bundle, error) {
+	var b Bundle
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return Bundle{}, fmt.Errorf("decode bundle: %w", err)
+	}
+	return b, nil
+}
+
+func fromLocale(loc smart.Locale) Locale {
+	out := Locale{
+		Dictionary: copyWords(loc.Dictionary),
+		Plurals:    fromPlurals(loc.Plurals),
+	}
+	if len(loc.PluralRules) > 0 {
+		out.PluralRules = map[string]string{}
+		for cat, rule := range loc.PluralRules {
+			out.PluralRules[cat.String()] = rule
+		}
+	}
+	if len(loc.Forms) > 0 {
+		out.Forms = map[string]map[string]map[string]string{}
+		for unit, contexts := range loc.Forms {
+			out.Forms[unit] = map[string]map[string]string{}
+			for ctx, forms := range contexts {
+				out.Forms[unit][ctx.String()] = fromCategories(forms)
+			}
+		}
+	}
+	if len(loc.Registers) > 0 {
+		out.Registers = map[string]Variant{}
+		for reg, v := range loc.Registers {
+			out.Registers[reg.String()] = Variant{Dictionary: copyWords(v.Dictionary), Plurals: fromPlurals(v.Plurals)}
+		}
+	}
+	return out
+}
+
+func (l Locale) toLocale(code string) (smart.Locale, error) {
+	out := smart.Locale{Code: code, Dictionary: copyWords(l.Dictionary)}
+
+	var err error
+	if out.Plurals, err = toPlurals(l.Plurals); err != nil {
+		return out, err
+	}
+	if len(l.PluralRules) > 0 {
+		if out.PluralRules, err = toCategories(l.PluralRules); err != nil {
+			return out, err
+		}
+	}
+	if len(l.Forms) > 0 {
+		out.Forms = map[string]map[smart.GrammaticalContext]map[smart.PluralCategory]string{}
+		for unit, contexts := range l.Forms {
+			out.Forms[unit] = map[smart.GrammaticalContext]map[smart.PluralCategory]string{}
+			for name, forms := range contexts {
+				ctx, err := smart.ParseGrammaticalContext(name)
+				if err != nil {
+					return out, err
+				}
+				if out.Forms[unit][ctx], err = toCategories(forms); err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(l.Registers) > 0 {
+		out.Registers = map[smart.Register]smart.LocaleVariant{}
+		for name, v := range l.Registers {
+			reg, err := smart.ParseRegister(name)
+			if err != nil {
+				return out, err
+			}
+			plurals, err := toPlurals(v.Plurals)
+			if err != nil {
+				return out, err
+			}
+			out.Registers[reg] = smart.LocaleVariant{Dictionary: copyWords(v.Dictionary), Plurals: plurals}
+		}
+	}
+	return out, nil
+}
+
+// copyWords copies a dictionary, so a bundle and the registry never share
+// a map that either side may change.
+func copyWords(words map[string]string) map[string]string {
+	if words == nil {
+		return nil
+	}
+	out := make(map[string]string, len(words))
+	for key, val := range words {
+		out[key] = val
+	}
+	return out
+}
+
+func fromCategories(forms map[smart.PluralCategory]string) map[string]string {
+	out := make(map[string]string, len(forms))
+	for cat, val := range forms {
+		out[cat.String()] = val
+	}
+	return out
+}
+
+func toCategories(forms map[string]string) (map[smart.PluralCategory]string, error) {
+	out := make(map[smart.PluralCategory]string, len(forms))
+	for name, val := range forms {
+		cat, err := smart.ParsePluralCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		out[cat] = val
+	}
+	return out, nil
+}
+
+func fromPlurals(plurals map[string]map[smart.PluralCategory]string) map[string]map[string]string {
+	if len(plurals) == 0 {
+		return nil
+	}
+	out := make(map[string]map[string]string, len(plurals))
+	for unit, forms := range plurals {
+		out[unit] = fromCategories(forms)
+	}
+	return out
+}
+
+func toPlurals(plurals map[string]map[string]string) (map[string]map[smart.PluralCategory]string, error) {
+	if len(plurals) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]map[smart.PluralCategory]string, len(plurals))
+	for unit, forms := range plurals {
+		converted, err := toCategories(forms)
+		if err != nil {
+			return nil, fmt.Errorf("unit %s: %w", unit, err)
+		}
+		out[unit] = converted
+	}
+	return out, nil
+}
@@ -0,0 +1,212 @@
+package bundle
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/regional"
+	"github.com/Roisfaozi/unik/timestamp/smart"
+)
+
+// preserveRegistries restores the smart locales and regional regions when t
+// ends, as Import changes both for the whole process.
+func preserveRegistries(t *testing.T) {
+	locales := map[string]smart.Locale{}
+	for _, loc := range smart.Locales() {
+		locales[loc.Code] = loc
+	}
+	regions := map[regional.Region]regional.RegionSpec{}
+	for _, region := range regional.Regions() {
+		regions[region], _ = regional.LookupRegion(region)
+	}
+
+	t.Cleanup(func() {
+		for _, loc := range smart.Locales() {
+			if _, ok := locales[loc.Code]; !ok {
+				smart.UnregisterLocale(loc.Code)
+			}
+		}
+		for _, loc := range locales {
+			smart.RegisterLocale(loc)
+		}
+		for _, region := range regional.Regions() {
+			if _, ok := regions[region]; !ok {
+				regional.UnregisterRegion(region)
+			}
+		}
+		for region, spec := range regions {
+			regional.RegisterRegion(region, spec)
+		}
+	})
+}
+
+func TestBundle_RoundTrip(t *testing.T) {
+	preserveRegistries(t)
+	exported := Export()
+	if exported.Version != Version {
+		t.Fatalf("Version = %d, want %d", exported.Version, Version)
+	}
+	for _, code := range []string{"en", "id", "de", "en-XA"} {
+		if _, ok := exported.Locales[code]; !ok {
+			t.Errorf("locale %q missing from export", code)
+		}
+	}
+	if r := exported.Regions["th"]; r.Pattern == "" || r.Calendar != "buddhist" || r.Locale != "th" {
+		t.Errorf("region th = %+v, want its pattern, calendar and locale", r)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, exported); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	read, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if !reflect.DeepEqual(exported, read) {
+		t.Errorf("Read(Write(Export())) differs from Export()")
+	}
+
+	// Re-importing the export must not change any output
+	if err := Import(read); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if !reflect.DeepEqual(exported, Export()) {
+		t.Errorf("Export() after Import() differs from the original export")
+	}
+}
+
+func TestImport(t *testing.T) {
+	preserveRegistries(t)
+	const doc = `{
+  "version": 1,
+  "locales": {
+    "pl": {
+      "pluralRules": {
+        "one": "i = 1 and v = 0",
+        "few": "v = 0 and i % 10 = 2..4 and i % 100 != 12..14"
+      },
+      "dictionary": {"just_now": "przed chwilą", "past": "{0} temu", "future": "za {0}"},
+      "plurals": {"min": {"one": "minutę", "few": "minuty", "other": "minut"}}
+    }
+  }
+}`
+
+	b, err := Read(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if err := Import(b); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	now := time.Now()
+	tests := []struct {
+		diff     time.Duration
+		expected string
+	}{
+		{-1 * time.Minute, "1 minutę temu"},
+		{-3 * time.Minute, "3 minuty temu"},
+		{-5 * time.Minute, "5 minut temu"},
+	}
+	for _, tt := range tests {
+		if got := smart.Social(now.Add(tt.diff), "pl", smart.StyleStandard); got != tt.expected {
+			t.Errorf("Social(%v) = %q, want %q", tt.diff, got, tt.expected)
+		}
+	}
+}
+
+func TestImport_Regions(t *testing.T) {
+	preserveRegistries(t)
+	const doc = `{
+  "version": 1,
+  "locales": {},
+  "regions": {
+    "us": {"pattern": "yyyy-MM-dd", "calendar": "gregorian"},
+    "th": {"pattern": "d MMMM y", "calendar": "gregorian", "locale": "th"},
+    "ke": {"pattern": "d/MM/yyyy", "calendar": "gregorian", "locale": "en"}
+  }
+}`
+
+	b, err := Read(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if err := Import(b); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	tm := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		region   regional.Region
+		expected string
+	}{
+		{regional.RegionUS, "2023-12-25"},
+		{regional.RegionTH, "25 ธันวาคม 2023"}, // Gregorian years instead of the Buddhist Era
+		{"ke", "25/12/2023"},
+	}
+	for _, tt := range tests {
+		if got := regional.Format(tm, tt.region, "", nil); got != tt.expected {
+			t.Errorf("Format(%s) = %q, want %q", tt.region, got, tt.expected)
+		}
+	}
+	if spec, _ := regional.LookupRegion(regional.RegionUS); spec.HourCycle != regional.HourCycle12 {
+		t.Errorf("Import() dropped the hour cycle of us: %+v", spec)
+	}
+}
+
+func TestImport_NoAliasing(t *testing.T) {
+	preserveRegistries(t)
+	justNow := smart.GetTrans("en", "just_now")
+
+	exported := Export()
+	exported.Locales["en"].Dictionary["just_now"] = "changed"
+	if got := smart.GetTrans("en", "just_now"); got != justNow {
+		t.Errorf("changing an export changed the registry: just_now = %q", got)
+	}
+
+	if err := Import(exported); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	exported.Locales["en"].Dictionary["just_now"] = "changed again"
+	if got := smart.GetTrans("en", "just_now"); got != "changed" {
+		t.Errorf("changing an imported bundle changed the registry: just_now = %q", got)
+	}
+}
+
+func TestImport_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"Wrong version", `{"version": 2, "locales": {}}`},
+		{"Unknown category", `{"version": 1, "locales": {"xx": {"plurals": {"day": {"several": "d"}}}}}`},
+		{"Unknown context", `{"version": 1, "locales": {"xx": {"forms": {"day": {"present": {"other": "d"}}}}}}`},
+		{"Unknown register", `{"version": 1, "locales": {"xx": {"registers": {"slang": {}}}}}`},
+		{"Bad rule", `{"version": 1, "locales": {"xx": {"pluralRules": {"one": "n = = 1"}}}}`},
+		{"Unknown calendar", `{"version": 1, "locales": {"xx": {}}, "regions": {"xx": {"pattern": "y", "calendar": "lunar", "locale": "en"}}}`},
+		{"Unsupported field", `{"version": 1, "locales": {"xx": {}}, "regions": {"xx": {"pattern": "y QQQ", "calendar": "gregorian", "locale": "en"}}}`},
+		{"New region without locale", `{"version": 1, "locales": {"xx": {}}, "regions": {"xx": {"pattern": "y", "calendar": "gregorian"}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Read(strings.NewReader(tt.doc))
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if err := Import(b); err == nil {
+				t.Errorf("Import() error = nil, want error")
+			}
+			if _, ok := smart.LookupLocale("xx"); ok {
+				t.Errorf("invalid bundle registered locale xx")
+			}
+			if _, ok := regional.LookupRegion("xx"); ok {
+				t.Errorf("invalid bundle registered region xx")
+			}
+		})
+	}
+}
@@ -5,18 +5,24 @@ import (
 	"time"
 
 	"github.com/Roisfaozi/unik/timestamp/regional"
+	"github.com/Roisfaozi/unik/timestamp/smart"
 )
 
 type Config struct {
 	DefaultTimezone string
-	Language        string
+	Language        string // Language of the words; empty is EN, or the region's own language in Regional and FormatPattern
 	Calendar        regional.CalendarSystem
+	Region          regional.Region  // Conventions for FormatPattern skeletons
+	DateStyle       regional.Style   // Date detail of Regional; StyleNone omits the date
+	TimeStyle       regional.Style   // Time detail of Regional; StyleNone omits the time
+	Translator      smart.Translator // nil uses the built-in locale registry
+	Register        smart.Register   // Formality of the built-in wording
 }
 
 var (
 	defaultConfig = Config{
 		DefaultTimezone: "UTC",
-		Language:        "en",
+		Language:        "",
 		Calendar:        nil,
 	}
 	configLock sync.RWMutex
@@ -59,6 +65,84 @@ func WithCalendar(cal regional.CalendarSystem) Option {
 	}
 }
 
+// WithRegion sets the region whose conventions resolve FormatPattern skeletons.
+//
+// Example:
+//
+//	FormatPattern(unix, "yMMMd", WithRegion(regional.RegionEU)) // "25 Dec 2023"
+func WithRegion(region regional.Region) Option {
+	return func(c *Config) {
+		c.Region = region
+	}
+}
+
+// WithDateStyle sets how much of the date Regional shows. Without WithDateStyle
+// and WithTimeStyle, Regional keeps the region's default format.
+//
+// Example:
+//
+//	Regional(unix, regional.RegionEU, WithDateStyle(regional.StyleFull)) // "Monday, 25 December 2023"
+func WithDateStyle(style regional.Style) Option {
+	return func(c *Config) {
+		c.DateStyle = style
+	}
+}
+
+// WithTimeStyle sets how much of the time Regional shows.
+//
+// Example:
+//
+//	Regional(unix, regional.RegionUS, WithDateStyle(regional.StyleShort), WithTimeStyle(regional.StyleShort)) // "12/25/23, 3:30 PM"
+//	Regional(unix, regional.RegionEU, WithTimeStyle(regional.StyleMedium))                                   // "15:30:00"
+func WithTimeStyle(style regional.Style) Option {
+	return func(c *Config) {
+		c.TimeStyle = style
+	}
+}
+
+// WithTranslator sets the Translator used for relative-time and duration strings,
+// e.g. an adapter over an application's existing message catalog.
+// The built-in locale registry (smart.RegistryTranslator) is used when unset.
+//
+// Example:
+//
+//	fmt.Println(Social(unix, WithTranslator(myCatalog), WithLanguage("es")))
+func WithTranslator(tr smart.Translator) Option {
+	return func(c *Config) {
+		c.Translator = tr
+	}
+}
+
+// WithRegister selects casual, neutral or formal wording from the built-in
+// locale registry. Locales without the requested register use neutral wording.
+// It has no effect on a custom Translator set with WithTranslator.
+//
+// Example:
+//
+//	Social(unix, WithLanguage("id"), WithRegister(smart.RegisterCasual)) // "5 mnt lalu"
+//	Social(unix, WithLanguage("id"), WithRegister(smart.RegisterFormal)) // "5 menit yang lalu"
+func WithRegister(reg smart.Register) Option {
+	return func(c *Config) {
+		c.Register = reg
+	}
+}
+
+// lang returns the language of the smart formatters, EN when none is set.
+func (c Config) lang() string {
+	if c.Language == "" {
+		return "en"
+	}
+	return c.Language
+}
+
+// formatter returns the smart.Formatter for this configuration.
+func (c Config) formatter() smart.Formatter {
+	if c.Translator == nil {
+		return smart.Formatter{Translator: smart.RegistryTranslator{Register: c.Register}}
+	}
+	return smart.Formatter{Translator: c.Translator}
+}
+
 // resolveConfig resolves the final configuration by applying a series of options
 // to a copy of the package's default configuration.
 //
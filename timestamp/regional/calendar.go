@@ -1,6 +1,9 @@
 package regional
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // CalendarSystem defines the interface for converting a Gregorian time
 // into a specific cultural calendar era and year.
@@ -9,3 +12,125 @@ type CalendarSystem interface {
 	// Returns: year, month, day, and era name (optional).
 	Transform(t time.Time) (year int, month int, day int, era string)
 }
+
+// NamedCalendar is a CalendarSystem whose months and eras have names of their
+// own. Name returns the CLDR calendar identifier ("islamic"); the names are
+// looked up in the smart locales under "<name>_month_N",
+// "<name>_month_short_N" and "<name>_era_N", so they follow the language.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionID, "", HijriCalendar{})) // Output: 12 Jumadil Akhir 1445 H
+type NamedCalendar interface {
+	CalendarSystem
+	Name() string
+}
+
+// EraCalendar is a NamedCalendar with more than one era. Era returns the CLDR
+// index of the era of t, used for the "<name>_era_N" name; calendars without
+// it have a single era, 0.
+type EraCalendar interface {
+	NamedCalendar
+	Era(t time.Time) int
+}
+
+// LeapYearCalendar is a NamedCalendar whose month names change in leap years,
+// as Adar becomes Adar II in a Hebrew leap year. The leap-year names are
+// looked up under "<name>_month_leap_N" and "<name>_month_short_leap_N".
+type LeapYearCalendar interface {
+	NamedCalendar
+	IsLeapYear(year int) bool
+}
+
+// LeapMonthCalendar is a NamedCalendar with leap months that repeat the
+// number of the month before them, as in the Chinese calendar. The name of a
+// leap month is the name of the month in the "<name>_month_pattern_leap"
+// pattern, e.g. "闰{0}" gives 闰四月.
+type LeapMonthCalendar interface {
+	NamedCalendar
+	IsLeapMonth(t time.Time) bool
+}
+
+// borrowedMonthCalendar is implemented by calendars that use the month names
+// of another calendar. monthNameKeys returns their key prefix, such as
+// "indian_", or "" for calendars that only count the years differently and
+// keep the Gregorian months.
+type borrowedMonthCalendar interface {
+	monthNameKeys() string
+}
+
+// yearNameCalendar is implemented by calendars that write some years with a
+// name, such as the first year of a Japanese era (元年). yearName returns the
+// key of the name without the calendar prefix, e.g. "gannen", or "" for a
+// numeric year.
+type yearNameCalendar interface {
+	yearName(year int) string
+}
+
+// BidirectionalCalendar is a NamedCalendar whose dates can be converted back
+// to Gregorian ones, and which describes its years and months.
+//
+// ToGregorian takes the era and the year of the era, as the G and y fields
+// show them; the era is the CLDR index returned by EraCalendar.Era and is
+// ignored by calendars with a single era. It returns the date at midnight UTC,
+// or an error when the month or day does not exist.
+//
+// The other methods take the extended year, which counts on through the eras:
+// it is the year of Transform in calendars with a single era, is 0 for the
+// year before year 1 of the current era (1 Before R.O.C., 1 BD) and is the
+// Gregorian year in the Japanese calendar, whose eras are reigns. Months are
+// numbered as in CLDR; in the Hebrew calendar, Adar I (6) only exists in
+// leap years, and DaysInMonth returns 0 for months that do not.
+//
+// Example:
+//
+//	t, _ := JapaneseCalendar{}.ToGregorian(JapaneseEraReiwa, 6, 5, 1)
+//	fmt.Println(t.Format("2006-01-02"))                             // Output: 2024-05-01
+//	fmt.Println(JapaneseCalendar{}.EraName(JapaneseEraReiwa, "ja")) // Output: 令和
+//	fmt.Println(HijriCalendar{}.DaysInMonth(1445, 9))               // Output: 30
+//	fmt.Println(HijriCalendar{}.MonthName(1445, 9, LangID))         // Output: Ramadan
+type BidirectionalCalendar interface {
+	NamedCalendar
+	ToGregorian(era, year, month, day int) (time.Time, error)
+	DaysInMonth(year, month int) int
+	MonthsInYear(year int) int
+	IsLeapYear(year int) bool
+	MonthName(year, month int, lang string) string
+	EraName(era int, lang string) string
+}
+
+// calendarTime validates a date of cal given in its extended year and
+// converts it with toGregorian, the arithmetic of the calendar.
+func calendarTime(cal BidirectionalCalendar, year, month, day int, toGregorian func(year, month, day int) (int, int, int)) (time.Time, error) {
+	if month < 1 || day < 1 || day > cal.DaysInMonth(year, month) {
+		return time.Time{}, fmt.Errorf("%s date %d/%d/%d does not exist", cal.Name(), year, month, day)
+	}
+	y, m, d := toGregorian(year, month, day)
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), nil
+}
+
+// monthName returns the wide name of a month of cal in lang, as the MMMM
+// field shows it.
+func monthName(cal NamedCalendar, year, month int, lang string) string {
+	date := dateFields{month: month, months: monthNames(cal)}
+	if leap, ok := cal.(LeapYearCalendar); ok {
+		date.leapYear = leap.IsLeapYear(year)
+	}
+	return date.monthField(patternField{letter: 'M', width: 4}, translate(lang))
+}
+
+// eraName returns the abbreviated name of an era of cal in lang, as the G
+// field shows it, or "" for an era without a name.
+func eraName(cal NamedCalendar, era int, lang string) string {
+	return lookup(translate(lang), fmt.Sprintf("%s_era_%d", cal.Name(), era), "")
+}
+
+// gregorianMonthDays returns the length of a Gregorian month, or 0 for
+// months that do not exist.
+func gregorianMonthDays(year, month int) int {
+	if month < 1 || month > 12 {
+		return 0
+	}
+	return time.Date(year, time.Month(month+1), 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,53 @@
+package regional
+
+import "time"
+
+// BuddhistCalendar implements CalendarSystem for the Thai solar calendar,
+// which counts years of the Buddhist Era (BE) from 543 BC. Months and days are
+// the Gregorian ones: 25 December 2023 is 25 December 2566 BE.
+type BuddhistCalendar struct{}
+
+// Name returns "buddhist", the CLDR name of the calendar.
+func (BuddhistCalendar) Name() string {
+	return "buddhist"
+}
+
+func (BuddhistCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	return t.Year() + 543, int(t.Month()), t.Day(), "BE"
+}
+
+func (bc BuddhistCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(bc, year, month, day, bc.toGregorian)
+}
+
+func (BuddhistCalendar) toGregorian(year, month, day int) (int, int, int) {
+	return year - 543, month, day
+}
+
+func (BuddhistCalendar) DaysInMonth(year, month int) int {
+	return gregorianMonthDays(year-543, month)
+}
+
+func (BuddhistCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// IsLeapYear reports whether the year has 29 February, as its Gregorian year
+// does.
+func (BuddhistCalendar) IsLeapYear(year int) bool {
+	return isGregorianLeap(year - 543)
+}
+
+// MonthName returns the Gregorian name of the month in lang.
+func (bc BuddhistCalendar) MonthName(year, month int, lang string) string {
+	return monthName(bc, year, month, lang)
+}
+
+// EraName returns the name of the Buddhist Era in lang, e.g. "พ.ศ." in Thai.
+func (bc BuddhistCalendar) EraName(era int, lang string) string {
+	return eraName(bc, 0, lang)
+}
+
+func (BuddhistCalendar) monthNameKeys() string {
+	return "" // Gregorian
+}
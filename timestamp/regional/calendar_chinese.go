@@ -0,0 +1,247 @@
+package regional
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/regional/internal/astro"
+)
+
+// ChineseCalendar implements CalendarSystem for the Chinese lunisolar
+// calendar (农历), as used for Lunar New Year in China, Singapore, Malaysia
+// and Indonesia. Months begin on the day of the new moon in Beijing; the
+// month containing the winter solstice is the 11th, and in years with 13 new
+// moons between two such months the first month without a major solar term
+// is a leap month (闰月) repeating the number of the month before it.
+//
+// Dates are computed from the positions of the sun and moon rather than from
+// tables, in Beijing time (local mean time before 1929), and agree with the
+// published calendar from 1900 to 2100. Earlier and later dates are computed
+// the same way, so a month may begin a day off where a new moon or solar term
+// falls near midnight, and the historical calendars before 1645, which used
+// mean solar terms, differ more.
+//
+// Transform returns the Gregorian year in which the Chinese year begins and
+// the sexagenary name of the year as the era, so "G" prints 甲辰 for 2024:
+//
+//	t := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionCN, "", ChineseCalendar{})) // Output: 甲辰2024年1月1日
+type ChineseCalendar struct{}
+
+// ChineseDate is a date of the Chinese calendar.
+type ChineseDate struct {
+	Year  int  // Gregorian year in which the Chinese year begins
+	Month int  // 1 to 12
+	Day   int  // 1 to 30
+	Leap  bool // The month is a leap month, e.g. 闰四月 after the fourth month
+	Cycle int  // Position of the year in the sexagenary cycle, from 1 (甲子) to 60 (癸亥)
+}
+
+// YearName returns the sexagenary name of the year in lang, e.g. "甲辰" in
+// Chinese and "jia-chen" in English for 2024.
+func (d ChineseDate) YearName(lang string) string {
+	return translate(lang)(fmt.Sprintf("chinese_era_%d", d.Cycle-1))
+}
+
+// Zodiac returns the zodiac animal of the year in lang, e.g. "龙" or "Dragon"
+// for 2024.
+func (d ChineseDate) Zodiac(lang string) string {
+	return translate(lang)(fmt.Sprintf("chinese_zodiac_%d", (d.Cycle-1)%12+1))
+}
+
+// Name returns "chinese", the CLDR name of the calendar.
+func (ChineseCalendar) Name() string {
+	return "chinese"
+}
+
+func (cc ChineseCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	d := cc.Date(t)
+	return d.Year, d.Month, d.Day, sexagenary(d.Cycle)
+}
+
+// Era returns the position of the year in the sexagenary cycle, from 0 (甲子).
+func (cc ChineseCalendar) Era(t time.Time) int {
+	return cc.Date(t).Cycle - 1
+}
+
+// IsLeapMonth reports whether t falls in a leap month.
+func (cc ChineseCalendar) IsLeapMonth(t time.Time) bool {
+	return cc.Date(t).Leap
+}
+
+// Date converts the calendar date of t to the Chinese calendar.
+// Algorithm: Dershowitz and Reingold, Calendrical Calculations
+func (ChineseCalendar) Date(t time.Time) ChineseDate {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+
+	s1 := chineseWinterSolstice(jd)
+	s2 := chineseWinterSolstice(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	leapYear := lunations(m12, nextM11) == 12
+
+	m := chineseNewMoonBefore(jd + 1)
+	month := lunations(m12, m)
+	if leapYear && chinesePriorLeapMonth(m12, m) {
+		month--
+	}
+	month = (month+11)%12 + 1
+	leap := leapYear && chineseNoMajorTerm(m) && !chinesePriorLeapMonth(m12, chineseNewMoonBefore(m))
+
+	year, _, _ := fromJulianDay(chineseNewYearOnOrBefore(jd))
+	return ChineseDate{
+		Year:  year,
+		Month: month,
+		Day:   jd - m + 1,
+		Leap:  leap,
+		Cycle: (year+56)%60 + 1, // 1984 was 甲子
+	}
+}
+
+// LunarNewYear returns the date of the Chinese New Year (春节) in the
+// Gregorian year, at midnight UTC.
+//
+// Example:
+//
+//	fmt.Println(LunarNewYear(2024).Format("2006-01-02")) // Output: 2024-02-10
+func LunarNewYear(year int) time.Time {
+	y, m, d := fromJulianDay(chineseNewYearOnOrBefore(julianDay(year, 7, 1)))
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+// SolarTerm is one of the 24 solar terms (节气), the moments the sun reaches
+// a multiple of 15 degrees of longitude.
+type SolarTerm struct {
+	Index int       // 1 (立春, spring begins, 315 degrees) to 24 (大寒, major cold), as in CLDR
+	Time  time.Time // Moment of the term, in UTC
+}
+
+// Name returns the name of the term in lang, e.g. "立春" or "spring begins".
+func (st SolarTerm) Name(lang string) string {
+	return translate(lang)(fmt.Sprintf("chinese_solar_term_%d", st.Index))
+}
+
+// Longitude returns the solar longitude of the term in degrees.
+func (st SolarTerm) Longitude() int {
+	return (315 + 15*(st.Index-1)) % 360
+}
+
+// SolarTerms returns the solar terms of the Gregorian year in UTC, in order;
+// the first is usually 小寒 (minor cold) around 5 January. The date of a term
+// in China is that of its Time in Beijing.
+//
+// Example:
+//
+//	terms := SolarTerms(2024)
+//	fmt.Println(terms[2].Name("zh"), terms[2].Time.Format("2006-01-02 15:04")) // Output: 立春 2024-02-04 08:26
+func SolarTerms(year int) []SolarTerm {
+	start := float64(julianDay(year, 1, 1)) - 0.5
+	end := float64(julianDay(year+1, 1, 1)) - 0.5
+
+	terms := make([]SolarTerm, 0, 24)
+	lambda := int(math.Ceil(astro.SolarLongitude(start)/15)*15) % 360
+	for moment := start; ; lambda = (lambda + 15) % 360 {
+		moment = astro.SolarLongitudeAfter(float64(lambda), moment)
+		if moment >= end {
+			return terms
+		}
+		index := (lambda/15+3)%24 + 1 // 315 degrees is the first
+		terms = append(terms, SolarTerm{Index: index, Time: julianTime(moment)})
+	}
+}
+
+var (
+	heavenlyStems   = []rune("甲乙丙丁戊己庚辛壬癸")
+	earthlyBranches = []rune("子丑寅卯辰巳午未申酉戌亥")
+)
+
+// sexagenary returns the name of the cycle position, e.g. 41 is 甲辰.
+func sexagenary(cycle int) string {
+	return string(heavenlyStems[(cycle-1)%10]) + string(earthlyBranches[(cycle-1)%12])
+}
+
+// chineseZone returns the offset of Beijing time from UT in days: the local
+// mean time of Beijing (116°25' E) until 1929, then UTC+8.
+func chineseZone(jd int) float64 {
+	if year, _, _ := fromJulianDay(jd); year < 1929 {
+		return (7 + 45.0/60 + 40.0/3600) / 24
+	}
+	return 8.0 / 24
+}
+
+// chineseMidnight returns the moment (UT) at which day jd begins in Beijing.
+func chineseMidnight(jd int) float64 {
+	return float64(jd) - 0.5 - chineseZone(jd)
+}
+
+// chineseDay returns the day in Beijing of a moment (UT).
+func chineseDay(moment float64) int {
+	approx := int(math.Floor(moment + 0.5 + 8.0/24))
+	return int(math.Floor(moment + 0.5 + chineseZone(approx)))
+}
+
+func chineseNewMoonOnOrAfter(jd int) int {
+	return chineseDay(astro.NewMoonAtOrAfter(chineseMidnight(jd)))
+}
+
+func chineseNewMoonBefore(jd int) int {
+	return chineseDay(astro.NewMoonBefore(chineseMidnight(jd)))
+}
+
+// chineseWinterSolstice returns the day of the last winter solstice on or
+// before jd.
+func chineseWinterSolstice(jd int) int {
+	return chineseDay(astro.SolarLongitudeBefore(270, chineseMidnight(jd+1)))
+}
+
+// chineseMajorTerm returns the last major solar term (中气) at the start of
+// day jd, numbered from 1 (雨水, 330 degrees) to 12.
+func chineseMajorTerm(jd int) int {
+	lambda := astro.SolarLongitude(chineseMidnight(jd))
+	return (int(lambda/30)+1)%12 + 1
+}
+
+// chineseNoMajorTerm reports whether the month beginning on day m contains
+// no major solar term.
+func chineseNoMajorTerm(m int) bool {
+	return chineseMajorTerm(m) == chineseMajorTerm(chineseNewMoonOnOrAfter(m+1))
+}
+
+// chinesePriorLeapMonth reports whether there is a leap month from the month
+// beginning on day from to the one beginning on day m.
+func chinesePriorLeapMonth(from, m int) bool {
+	for ; m >= from; m = chineseNewMoonBefore(m) {
+		if chineseNoMajorTerm(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// chineseNewYearInSui returns the new year of the year from the winter
+// solstice before jd to the next one.
+func chineseNewYearInSui(jd int) int {
+	s1 := chineseWinterSolstice(jd)
+	s2 := chineseWinterSolstice(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	m13 := chineseNewMoonOnOrAfter(m12 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	if lunations(m12, nextM11) == 12 && (chineseNoMajorTerm(m12) || chineseNoMajorTerm(m13)) {
+		return chineseNewMoonOnOrAfter(m13 + 1)
+	}
+	return m13
+}
+
+func chineseNewYearOnOrBefore(jd int) int {
+	if newYear := chineseNewYearInSui(jd); jd >= newYear {
+		return newYear
+	}
+	return chineseNewYearInSui(jd - 180)
+}
+
+// lunations returns the number of months from the new moon on day from to the
+// one on day to.
+func lunations(from, to int) int {
+	return int(math.Round(float64(to-from) / astro.MeanSynodicMonth))
+}
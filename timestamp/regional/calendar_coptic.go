@@ -0,0 +1,111 @@
+package regional
+
+import "time"
+
+// CopticCalendar implements CalendarSystem for the calendar of the Coptic
+// Orthodox Church, still used in Egypt for the agricultural year and church
+// feasts. Years of the Martyrs (Anno Martyrum) are counted from 284 AD, the
+// accession of Diocletian; each has twelve months of 30 days followed by
+// Nasie, the 13th month, of 5 days, or 6 in the year before a Julian leap
+// year. The year begins on 1 Tout, 11 September (12 September before a
+// Gregorian leap year) from 1900 to 2099.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionEG, "", CopticCalendar{})) // Output: 15 كيهك 1740 ش
+//	fmt.Println(Format(t, RegionUS, "", CopticCalendar{})) // Output: Kiahk 15, 1740 AM
+type CopticCalendar struct{}
+
+// copticEpoch is the Julian Day Number of 1 Tout 1 AM (29 August 284 Julian).
+const copticEpoch = 1825030
+
+// Name returns "coptic", the CLDR name of the calendar.
+func (CopticCalendar) Name() string {
+	return "coptic"
+}
+
+// Era returns 1 (Anno Martyrum) from 1 Tout 1 on and 0 (Before Diocletian)
+// before.
+func (CopticCalendar) Era(t time.Time) int {
+	if julianDay(t.Year(), int(t.Month()), t.Day()) >= copticEpoch {
+		return 1
+	}
+	return 0
+}
+
+func (cc CopticCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	year, month, day = alexandrianDate(copticEpoch, julianDay(t.Year(), int(t.Month()), t.Day()))
+	if cc.Era(t) == 0 {
+		return 1 - year, month, day, "BD"
+	}
+	return year, month, day, "AM"
+}
+
+// ToGregorian converts a date of era 1 (Anno Martyrum) or of era 0 (Before
+// Diocletian), whose years count backwards.
+func (cc CopticCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	if era == 0 {
+		year = 1 - year
+	}
+	return calendarTime(cc, year, month, day, cc.toGregorian)
+}
+
+func (CopticCalendar) toGregorian(year, month, day int) (int, int, int) {
+	return fromJulianDay(alexandrianNewYear(copticEpoch, year) + 30*(month-1) + day - 1)
+}
+
+// DaysInMonth returns 30, or 5 for Nasie (13), 6 in leap years.
+func (cc CopticCalendar) DaysInMonth(year, month int) int {
+	return alexandrianMonthDays(cc.IsLeapYear(year), month)
+}
+
+func (CopticCalendar) MonthsInYear(year int) int {
+	return 13
+}
+
+// IsLeapYear reports whether Nasie has 6 days in the year.
+func (CopticCalendar) IsLeapYear(year int) bool {
+	return floorMod(year, 4) == 3
+}
+
+// MonthName returns the name of the month in lang, e.g. "كيهك" or "Kiahk"
+// for 4.
+func (cc CopticCalendar) MonthName(year, month int, lang string) string {
+	return monthName(cc, year, month, lang)
+}
+
+// EraName returns the name of the era in lang, e.g. "ش" or "AM" for era 1.
+func (cc CopticCalendar) EraName(era int, lang string) string {
+	return eraName(cc, era, lang)
+}
+
+// alexandrianDate converts Julian Day jd to a date of the Alexandrian
+// calendar shared by the Coptic and Ethiopian ones, whose 1 January of year 1
+// is epoch. Years before 1 are counted down through 0.
+// Algorithm: Dershowitz and Reingold, Calendrical Calculations
+func alexandrianDate(epoch, jd int) (year, month, day int) {
+	year = floorDiv(4*(jd-epoch)+1463, 1461)
+	days := jd - alexandrianNewYear(epoch, year)
+	return year, days/30 + 1, days%30 + 1
+}
+
+// alexandrianNewYear returns the Julian Day of the first day of the year; a
+// year has 366 days when it leaves a remainder of 3 when divided by 4.
+func alexandrianNewYear(epoch, year int) int {
+	return epoch + 365*(year-1) + floorDiv(year, 4)
+}
+
+// alexandrianMonthDays returns the length of a month of the Alexandrian
+// calendar: 30 days, and 5 or, in leap years, 6 for the 13th.
+func alexandrianMonthDays(leap bool, month int) int {
+	switch {
+	case month < 1 || month > 13:
+		return 0
+	case month < 13:
+		return 30
+	case leap:
+		return 6
+	}
+	return 5
+}
@@ -0,0 +1,90 @@
+package regional
+
+import "time"
+
+// EthiopianCalendar implements CalendarSystem for the Ethiopian calendar,
+// the official calendar of Ethiopia. It has the months of the Coptic
+// calendar, twelve of 30 days and Pagume (Pagumen), the 13th, of 5 or 6 days,
+// but counts the years of the Incarnation (Amete Mihret) from 8 AD, so it
+// runs 7 years behind the Gregorian calendar from 1 Meskerem (11 or 12
+// September) to the end of December and 8 years from January on.
+//
+// Dates before 1 Meskerem 1 are counted in the era of the World (Amete Alem),
+// whose year 5501 is year 1 of the Incarnation.
+//
+// Example:
+//
+//	t := time.Date(2023, time.September, 12, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionET, "", nil))                 // Output: 01/01/2016
+//	fmt.Println(Format(t, RegionET, "", EthiopianCalendar{})) // Output: 1 መስከረም 2016
+//	fmt.Println(Format(t, RegionUS, "", EthiopianCalendar{})) // Output: Meskerem 1, 2016 EC
+type EthiopianCalendar struct{}
+
+// ethiopianEpoch is the Julian Day Number of 1 Meskerem 1 EC (29 August 8
+// Julian).
+const ethiopianEpoch = 1724221
+
+// ameteAlem is the number of years from the era of the World to that of the
+// Incarnation.
+const ameteAlem = 5500
+
+// Name returns "ethiopic", the CLDR name of the calendar.
+func (EthiopianCalendar) Name() string {
+	return "ethiopic"
+}
+
+// Era returns 1 (Amete Mihret) from 1 Meskerem 1 on and 0 (Amete Alem)
+// before.
+func (EthiopianCalendar) Era(t time.Time) int {
+	if julianDay(t.Year(), int(t.Month()), t.Day()) >= ethiopianEpoch {
+		return 1
+	}
+	return 0
+}
+
+func (ec EthiopianCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	year, month, day = alexandrianDate(ethiopianEpoch, julianDay(t.Year(), int(t.Month()), t.Day()))
+	if ec.Era(t) == 0 {
+		return year + ameteAlem, month, day, "AA"
+	}
+	return year, month, day, "EC"
+}
+
+// ToGregorian converts a date of era 1 (Amete Mihret) or of era 0 (Amete
+// Alem).
+func (ec EthiopianCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	if era == 0 {
+		year -= ameteAlem
+	}
+	return calendarTime(ec, year, month, day, ec.toGregorian)
+}
+
+func (EthiopianCalendar) toGregorian(year, month, day int) (int, int, int) {
+	return fromJulianDay(alexandrianNewYear(ethiopianEpoch, year) + 30*(month-1) + day - 1)
+}
+
+// DaysInMonth returns 30, or 5 for Pagume (13), 6 in leap years.
+func (ec EthiopianCalendar) DaysInMonth(year, month int) int {
+	return alexandrianMonthDays(ec.IsLeapYear(year), month)
+}
+
+func (EthiopianCalendar) MonthsInYear(year int) int {
+	return 13
+}
+
+// IsLeapYear reports whether Pagume has 6 days in the year, as it does in
+// the year before a Gregorian leap year.
+func (EthiopianCalendar) IsLeapYear(year int) bool {
+	return floorMod(year, 4) == 3
+}
+
+// MonthName returns the name of the month in lang, e.g. "መስከረም" or
+// "Meskerem" for 1.
+func (ec EthiopianCalendar) MonthName(year, month int, lang string) string {
+	return monthName(ec, year, month, lang)
+}
+
+// EraName returns the name of the era in lang, e.g. "ዓ/ም" or "EC" for era 1.
+func (ec EthiopianCalendar) EraName(era int, lang string) string {
+	return eraName(ec, era, lang)
+}
@@ -0,0 +1,148 @@
+package regional
+
+import "time"
+
+// HebrewCalendar implements CalendarSystem for the Hebrew (Jewish) calendar.
+// Years are counted from the creation (Anno Mundi) and begin on 1 Tishri,
+// whose date follows the molad (mean new moon) of Tishri and the postponement
+// rules (dehiyyot); 7 of every 19 years add the month Adar I.
+//
+// Months are numbered from Tishri as in CLDR: 1 Tishri, 2 Heshvan, 3 Kislev,
+// 4 Tevet, 5 Shevat, 6 Adar I (leap years only), 7 Adar, called Adar II in
+// leap years, then 8 Nisan to 13 Elul.
+//
+// Example:
+//
+//	t := time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionUS, "", HebrewCalendar{})) // Output: Adar II 15, 5784 AM
+type HebrewCalendar struct{}
+
+// hebrewEpoch is the Julian Day Number of the day before 1 Tishri 1 AM
+// (7 October 3761 BC, proleptic Julian).
+const hebrewEpoch = 347997
+
+// Name returns "hebrew", the CLDR name of the calendar.
+func (HebrewCalendar) Name() string {
+	return "hebrew"
+}
+
+// IsLeapYear reports whether year has 13 months.
+func (HebrewCalendar) IsLeapYear(year int) bool {
+	return isHebrewLeap(year)
+}
+
+func (HebrewCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+
+	// The mean year is 35975351/98496 days; postponements move 1 Tishri by
+	// up to two days either side of the estimate
+	year = (jd-hebrewEpoch)*98496/35975351 + 1
+	for hebrewNewYear(year) > jd {
+		year--
+	}
+	for hebrewNewYear(year+1) <= jd {
+		year++
+	}
+
+	day = jd - hebrewNewYear(year) + 1
+	for month = 1; day > hebrewMonthDays(year, month); month++ {
+		day -= hebrewMonthDays(year, month)
+	}
+	return year, month, day, "AM"
+}
+
+func (hc HebrewCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(hc, year, month, day, hc.toGregorian)
+}
+
+func (HebrewCalendar) toGregorian(year, month, day int) (int, int, int) {
+	jd := hebrewNewYear(year) + day - 1
+	for m := 1; m < month; m++ {
+		jd += hebrewMonthDays(year, m)
+	}
+	return fromJulianDay(jd)
+}
+
+// DaysInMonth returns the length of the month, which for Heshvan and Kislev
+// depends on the length of the year; Adar I (6) has none in common years.
+func (HebrewCalendar) DaysInMonth(year, month int) int {
+	if month < 1 || month > 13 {
+		return 0
+	}
+	return hebrewMonthDays(year, month)
+}
+
+// MonthsInYear returns 13 in leap years and 12 in common years, which skip
+// Adar I (6).
+func (HebrewCalendar) MonthsInYear(year int) int {
+	if isHebrewLeap(year) {
+		return 13
+	}
+	return 12
+}
+
+// MonthName returns the name of the month in lang; Adar (7) is named Adar II
+// in leap years.
+func (hc HebrewCalendar) MonthName(year, month int, lang string) string {
+	return monthName(hc, year, month, lang)
+}
+
+func (hc HebrewCalendar) EraName(era int, lang string) string {
+	return eraName(hc, 0, lang)
+}
+
+func isHebrewLeap(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishri of
+// year, postponed when it falls on a Sunday, Wednesday or Friday.
+// Algorithm: Dershowitz and Reingold, Calendrical Calculations
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	days := 29*months + parts/25920
+	if (3*(days+1))%7 < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYear returns the Julian Day Number of 1 Tishri of year, applying
+// the postponements that keep the year between 353-355 or 383-385 days.
+func hebrewNewYear(year int) int {
+	prev, cur, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	delay := 0
+	switch {
+	case next-cur == 356:
+		delay = 2
+	case cur-prev == 382:
+		delay = 1
+	}
+	return hebrewEpoch + cur + delay + 1
+}
+
+// hebrewMonthDays returns the length of month in year; Adar I (6) has none in
+// common years.
+func hebrewMonthDays(year, month int) int {
+	switch month {
+	case 2: // Heshvan is long in complete years
+		if (hebrewNewYear(year+1)-hebrewNewYear(year))%10 == 5 {
+			return 30
+		}
+		return 29
+	case 3: // Kislev is short in deficient years
+		if (hebrewNewYear(year+1)-hebrewNewYear(year))%10 == 3 {
+			return 29
+		}
+		return 30
+	case 6:
+		if !isHebrewLeap(year) {
+			return 0
+		}
+		return 30
+	case 1, 5, 8, 10, 12:
+		return 30
+	}
+	return 29
+}
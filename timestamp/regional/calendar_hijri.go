@@ -1,88 +1,289 @@
 package regional
 
-import "time"
+import (
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/regional/internal/astro"
+)
 
 // HijriCalendar implements CalendarSystem for the Islamic (Hijri) calendar.
-// This implementation uses a standard arithmetic (tabular) approximation.
-// Note: Actual Islamic dates depend on visual moon sighting (Rukyat) and may vary by 1-2 days.
-type HijriCalendar struct{}
+// By default it uses the arithmetic (tabular) calendar of the Kuwaiti
+// algorithm, Type II; Variant selects another tabular leap-year cycle, the
+// Umm al-Qura calendar of Saudi Arabia, or months computed from the crescent
+// moon at a place, as Indonesia and Malaysia do (HijriAstronomical, from 1318
+// to 1523 AH, 1900 to 2100).
+//
+// Actual Islamic dates depend on visual moon sighting (Rukyat) and may vary by
+// 1-2 days from a computed calendar. Adjustment shifts the dates by whole
+// days, as Islamic apps let users do, so they match a local announcement.
+//
+// Example:
+//
+//	t := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionSA, "", HijriCalendar{Variant: HijriUmmAlQura})) // Output: 29 شعبان 1445 هـ
+//	fmt.Println(Format(t, RegionID, "", HijriCalendar{Adjustment: 1}))           // Output: 1 Ramadan 1445 H
+type HijriCalendar struct {
+	Variant    HijriVariant   // How months are computed; Type II when zero
+	Adjustment int            // Days added to the computed date, e.g. -1 when months begin a day later locally
+	Observer   *HilalObserver // Place and criterion of HijriAstronomical; Banda Aceh with MABIMS when nil
+}
+
+// HijriVariant selects the leap years or month tables of HijriCalendar.
+type HijriVariant int
+
+const (
+	HijriTypeII       HijriVariant = iota // Kuwaiti algorithm: leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of 30
+	HijriTypeI                            // Leap years 2, 5, 7, 10, 13, 15, 18, 21, 24, 26 and 29
+	HijriTypeIII                          // Fatimid (Misri): leap years 2, 5, 8, 10, 13, 16, 19, 21, 24, 27 and 29
+	HijriTypeIV                           // Habash al-Hasib: leap years 2, 5, 8, 11, 13, 16, 19, 21, 24, 27 and 30
+	HijriUmmAlQura                        // Official calendar of Saudi Arabia, from its tables for 1300-1600 AH; Type II outside
+	HijriAstronomical                     // Months begin after the evening the crescent meets the criterion of the Observer, from 1318 to 1523 AH; Type II outside
+)
+
+// Years of HijriAstronomical, from 1 Muharram 1318 (1 May 1900) to the end of
+// 1523 (11 March 2100), within the range of the astronomical series.
+const (
+	hijriAstronomicalFirstYear = 1318
+	hijriAstronomicalLastYear  = 1523
+)
+
+// HilalObserver is the place from which HijriAstronomical looks for the
+// crescent (hilal) after each new moon, and the criterion it applies. The
+// default, Banda Aceh, gives the months of the Indonesian government, which
+// accepts the crescent seen anywhere in the country and so first in the west.
+//
+// Example:
+//
+//	// Muhammadiyah, from Yogyakarta, and Malaysia, from Kuala Lumpur
+//	yogyakarta := &HilalObserver{Latitude: -7.80, Longitude: 110.36, Criterion: WujudulHilal}
+//	kualaLumpur := &HilalObserver{Latitude: 3.14, Longitude: 101.69}
+//	t, _ := HijriCalendar{Variant: HijriAstronomical, Observer: yogyakarta}.ToGregorian(0, 1445, 9, 1)
+//	fmt.Println(t.Format("2006-01-02")) // Output: 2024-03-11
+//	t, _ = HijriCalendar{Variant: HijriAstronomical, Observer: kualaLumpur}.ToGregorian(0, 1445, 9, 1)
+//	fmt.Println(t.Format("2006-01-02")) // Output: 2024-03-12
+type HilalObserver struct {
+	Latitude  float64        // North latitude in degrees
+	Longitude float64        // East longitude in degrees
+	Criterion HilalCriterion // MABIMS when nil
+}
+
+// HilalCriterion reports whether a new month begins after the evening of an
+// observation.
+type HilalCriterion func(HilalObservation) bool
+
+// HilalObservation describes the moon at sunset on an evening after the new
+// moon, as computed by HijriAstronomical.
+type HilalObservation struct {
+	Sunset      time.Time     // Moment of sunset, in UTC
+	Conjunction time.Time     // Moment of the new moon, in UTC
+	Age         time.Duration // Time from the conjunction to sunset; negative when the new moon is after sunset
+	Altitude    float64       // Altitude of the upper limb of the moon at sunset in degrees, with parallax and without refraction
+	Elongation  float64       // Angle between the centres of the sun and moon at sunset in degrees
+}
+
+// MABIMS is the imkanur rukyat criterion of Indonesia, Malaysia, Brunei and
+// Singapore since 2022: the moon at least 3° above the horizon at sunset and
+// 6.4° from the sun.
+func MABIMS(o HilalObservation) bool {
+	return o.Altitude >= 3 && o.Elongation >= 6.4
+}
+
+// WujudulHilal is the criterion of Muhammadiyah: the new moon before sunset
+// and the moon still above the horizon when the sun sets.
+func WujudulHilal(o HilalObservation) bool {
+	return o.Age > 0 && o.Altitude > 0
+}
+
+// bandaAceh is the default HilalObserver.
+var bandaAceh = HilalObserver{Latitude: 5.5483, Longitude: 95.3238, Criterion: MABIMS}
+
+// hijriEpoch is the Julian Day Number of 1 Muharram 1 AH (16 July 622
+// Julian), the civil (Friday) epoch.
+const hijriEpoch = 1948440
+
+// Name returns "islamic", the CLDR name of the calendar.
+func (hc HijriCalendar) Name() string {
+	return "islamic"
+}
 
 func (hc HijriCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
-	// 1. Convert Gregorian to Julian Day (JD) using standard integer math
-	// Algorithm: Fliegel and Van Flandern (1968)
-	gYear := t.Year()
-	gMonth := int(t.Month())
-	gDay := t.Day()
-
-	a := (14 - gMonth) / 12
-	y := gYear + 4800 - a
-	m := gMonth + 12*a - 3
-	
-	// JD at noon
-	jd := gDay + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
-
-	// 2. Convert JD to Hijri
-	// Epoch: July 16, 622 AD (Julian Calendar) = JD 1948440
-	// Epoch: July 16, 622 AD (Julian Calendar) = JD 1948440
-	// Standard Tabular Islamic Calendar (Kuwaiti Algorithm)
-	days := jd - 1948440
-
-	// 30-year cycle: 10631 days
-	cycle := days / 10631
-	days = days % 10631
-
-	hYear := 1 + (int(cycle) * 30)
-
-	// Find year within cycle
-	for {
-		daysInYear := 354
-		if isHijriLeap(hYear) {
-			daysInYear = 355
+	year, month, day = hc.date(julianDay(t.Year(), int(t.Month()), t.Day()) + hc.Adjustment)
+	return year, month, day, "AH"
+}
+
+// date converts a Julian Day, with Adjustment already added, to a date of the
+// calendar.
+func (hc HijriCalendar) date(jd int) (year, month, day int) {
+	if hc.Variant == HijriAstronomical {
+		// Start from the tabular month, then settle on the one containing jd
+		year, month, _ = HijriCalendar{}.date(jd)
+		n := 12*(year-1) + month - 1
+		for hc.monthStart(n) > jd {
+			n--
 		}
-		if days < daysInYear {
-			break
+		for hc.monthStart(n+1) <= jd {
+			n++
 		}
-		days -= daysInYear
-		hYear++
-	}
-
-	// Find month
-	hMonth := 1
-	for {
-		daysInMonth := 29
-		// Odd months have 30 days, Even have 29
-		// Except 12th month in leap year has 30
-		if hMonth%2 != 0 {
-			daysInMonth = 30
-		} else {
-			// Month 12 in leap year
-			if hMonth == 12 && isHijriLeap(hYear) {
-				daysInMonth = 30
-			}
+		return floorDiv(n, 12) + 1, floorMod(n, 12) + 1, jd - hc.monthStart(n) + 1
+	}
+
+	// Estimate from the mean year, then settle on the year containing jd
+	year = floorDiv(30*(jd-hijriEpoch)+10646, 10631)
+	for hc.newYear(year+1) <= jd {
+		year++
+	}
+	for hc.newYear(year) > jd {
+		year--
+	}
+
+	day = jd - hc.newYear(year) + 1
+	month = 1
+	for month < 12 && day > hc.DaysInMonth(year, month) {
+		day -= hc.DaysInMonth(year, month)
+		month++
+	}
+	return year, month, day
+}
+
+func (hc HijriCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(hc, year, month, day, hc.toGregorian)
+}
+
+func (hc HijriCalendar) toGregorian(year, month, day int) (int, int, int) {
+	if hc.Variant == HijriAstronomical {
+		return fromJulianDay(hc.monthStart(12*(year-1)+month-1) + day - 1 - hc.Adjustment)
+	}
+	jd := hc.newYear(year) + day - 1 - hc.Adjustment
+	for m := 1; m < month; m++ {
+		jd += hc.DaysInMonth(year, m)
+	}
+	return fromJulianDay(jd)
+}
+
+// DaysInMonth returns 30 for the odd months and 29 for the even ones, but 30
+// for Dhu al-Hijjah in leap years; Umm al-Qura months follow its tables, and
+// astronomical ones the moon.
+func (hc HijriCalendar) DaysInMonth(year, month int) int {
+	switch {
+	case month < 1 || month > 12:
+		return 0
+	case hc.Variant == HijriAstronomical:
+		n := 12*(year-1) + month - 1
+		return hc.monthStart(n+1) - hc.monthStart(n)
+	case hc.ummAlQura(year):
+		return 29 + int(ummAlQuraMonths[year-ummAlQuraFirstYear]>>(month-1)&1)
+	case month%2 == 1 || month == 12 && hc.IsLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+func (HijriCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// IsLeapYear reports whether the year has 355 days, or more in the
+// astronomical calendar.
+func (hc HijriCalendar) IsLeapYear(year int) bool {
+	if hc.Variant == HijriAstronomical {
+		return hc.newYear(year+1)-hc.newYear(year) > 354
+	}
+	if hc.ummAlQura(year) {
+		return bits.OnesCount16(ummAlQuraMonths[year-ummAlQuraFirstYear]) == 7
+	}
+	return floorMod(11*year+hc.Variant.leapShift(), 30) < 11
+}
+
+// MonthName returns the name of the month in lang, e.g. "Ramadan" for 9.
+func (hc HijriCalendar) MonthName(year, month int, lang string) string {
+	return monthName(hc, year, month, lang)
+}
+
+// EraName returns the name of the Hijri era in lang, e.g. "هـ" or "AH".
+func (hc HijriCalendar) EraName(era int, lang string) string {
+	return eraName(hc, 0, lang)
+}
+
+// ummAlQura reports whether the year is read from the Umm al-Qura tables.
+func (hc HijriCalendar) ummAlQura(year int) bool {
+	return hc.Variant == HijriUmmAlQura && year >= ummAlQuraFirstYear && year <= ummAlQuraLastYear
+}
+
+// newYear returns the Julian Day of 1 Muharram of the year, before
+// Adjustment.
+// Algorithm: Dershowitz and Reingold, Calendrical Calculations
+func (hc HijriCalendar) newYear(year int) int {
+	if hc.Variant == HijriAstronomical {
+		return hc.monthStart(12 * (year - 1))
+	}
+	if hc.ummAlQura(year) {
+		jd := ummAlQuraEpoch
+		for _, months := range ummAlQuraMonths[:year-ummAlQuraFirstYear] {
+			jd += 29*12 + bits.OnesCount16(months)
 		}
+		return jd
+	}
+	// 11 of every 30 years have 355 days
+	return hijriEpoch + 354*(year-1) + floorDiv(11*year+hc.Variant.leapShift()-11, 30)
+}
 
-		if days < daysInMonth {
-			break
+// monthStart returns the Julian Day of the first day of the n-th astronomical
+// month since the epoch, before Adjustment: the day after the first evening on
+// which the crescent meets the criterion, and at the latest the third evening
+// after the new moon. Outside the years of HijriAstronomical it is the first
+// day of the Type II month.
+func (hc HijriCalendar) monthStart(n int) int {
+	if year := floorDiv(n, 12) + 1; year < hijriAstronomicalFirstYear || year > hijriAstronomicalLastYear {
+		tabular := HijriCalendar{}
+		jd := tabular.newYear(year)
+		for m := 1; m <= floorMod(n, 12); m++ {
+			jd += tabular.DaysInMonth(year, m)
 		}
-		days -= daysInMonth
-		hMonth++
+		return jd
+	}
+
+	observer := bandaAceh
+	if hc.Observer != nil {
+		observer = *hc.Observer
+	}
+	if observer.Criterion == nil {
+		observer.Criterion = MABIMS
 	}
 
-	hDay := days + 1 // days is 0-indexed remainder
+	// The mean month begins within a day or two of the new moon
+	approx := float64(hijriEpoch) + float64(n)*astro.MeanSynodicMonth
+	conjunction := astro.NewMoonAtOrAfter(approx - 10)
 
-	return hYear, hMonth, hDay, "AH"
+	// Days begin at local mean midnight
+	zone := observer.Longitude / 360
+	evening := int(math.Floor(conjunction + 0.5 + zone))
+	for last := evening + 2; evening < last; evening++ {
+		sunset := astro.Sunset(float64(evening)-0.5-zone, observer.Latitude, observer.Longitude)
+		if observer.Criterion(HilalObservation{
+			Sunset:      julianTime(sunset),
+			Conjunction: julianTime(conjunction),
+			Age:         time.Duration((sunset - conjunction) * 24 * float64(time.Hour)),
+			Altitude:    astro.LunarAltitude(sunset, observer.Latitude, observer.Longitude) + astro.LunarSemidiameter(sunset),
+			Elongation:  astro.Elongation(sunset),
+		}) {
+			break
+		}
+	}
+	return evening + 1
 }
 
-// isHijriLeap checks if year is leap in the 30-year tabular cycle (Kuwaiti / Type II)
-// Leap years: 2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29
-func isHijriLeap(year int) bool {
-	mod := year % 30
-	switch mod {
-	case 2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29:
-		return true
+// leapShift returns the c of the variant's leap-year rule: year y of the
+// 30-year cycle has 355 days when (11y + c) mod 30 < 11.
+func (v HijriVariant) leapShift() int {
+	switch v {
+	case HijriTypeI:
+		return 15
+	case HijriTypeIII:
+		return 11
+	case HijriTypeIV:
+		return 9
 	}
-	// Note: Year 30 is NOT leap in Type II? 
-	// Standard Type II (Kuwaiti): 2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29
-	// Some variations include 15 instead of 16. We stick to Kuwaiti.
-	return false
+	return 14
 }
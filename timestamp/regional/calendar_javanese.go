@@ -0,0 +1,186 @@
+package regional
+
+import (
+	"fmt"
+	"time"
+)
+
+// JavaneseCalendar implements CalendarSystem for the Javanese (Sultan Agungan)
+// calendar, the lunar calendar Sultan Agung of Mataram introduced on 1 Sura
+// 1555 AJ, 8 July 1633. Its months follow the Hijri ones (Sura is Muharram)
+// but its years are counted on from the Saka era and grouped in windu of
+// eight years, Alip to Jimakir, of which Ehe, Dal and Jimakir have 355 days.
+//
+// A windu of 2835 days is a little longer than eight lunar years, so every
+// 120 years (a kurup) the calendar drops a day. The current kurup, Asapon,
+// began on Tuesday (Selasa) Pon, 1 Sura 1867 AJ (24 March 1936); the next,
+// Anenhing, begins on 1 Sura 1987 AJ (26 August 2052). Dates before 1633 are
+// proleptic.
+//
+// The names of the months and of the pasaran days are Javanese in every
+// language.
+//
+// Example:
+//
+//	t := time.Date(2024, time.July, 8, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionID, "", JavaneseCalendar{})) // Output: 1 Sura 1958 AJ
+//	fmt.Println(JavaneseCalendar{}.Weton(t).Name(LangID))   // Output: Senin Legi
+type JavaneseCalendar struct{}
+
+// javaneseEpoch is the Julian Day of 1 Sura 1555 AJ, Friday (Jumat) Legi.
+const javaneseEpoch = 2317690
+
+// windu is the length of the eight-year cycle in days.
+const windu = 2835
+
+// javaneseYearDays lists the lengths of the years of a windu, from Alip.
+var javaneseYearDays = [8]int{354, 355, 354, 354, 355, 354, 354, 355}
+
+// Name returns "javanese". CLDR has no Javanese calendar; the names are in
+// the hand-written locales.
+func (JavaneseCalendar) Name() string {
+	return "javanese"
+}
+
+func (JavaneseCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+
+	// Estimate from the mean year, then settle on the year whose 1 Sura is
+	// on or before jd.
+	year = 1555 + (jd-javaneseEpoch)*8/windu
+	for javaneseNewYear(year) > jd {
+		year--
+	}
+	for javaneseNewYear(year+1) <= jd {
+		year++
+	}
+
+	days := jd - javaneseNewYear(year)
+	month = 1
+	for days >= javaneseMonthDays(year, month) {
+		days -= javaneseMonthDays(year, month)
+		month++
+	}
+	return year, month, days + 1, "AJ"
+}
+
+func (jc JavaneseCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(jc, year, month, day, jc.toGregorian)
+}
+
+func (JavaneseCalendar) toGregorian(year, month, day int) (int, int, int) {
+	jd := javaneseNewYear(year) + day - 1
+	for m := 1; m < month; m++ {
+		jd += javaneseMonthDays(year, m)
+	}
+	return fromJulianDay(jd)
+}
+
+// WinduYear returns the position of the year in the windu, from 1 (Alip) to
+// 8 (Jimakir).
+func (JavaneseCalendar) WinduYear(year int) int {
+	return floorMod(year-1555, 8) + 1
+}
+
+// WinduYearName returns the name of the year in the windu, e.g. "Je" for
+// 1958 AJ.
+func (jc JavaneseCalendar) WinduYearName(year int, lang string) string {
+	return translate(lang)(fmt.Sprintf("javanese_year_%d", jc.WinduYear(year)))
+}
+
+// IsLeapYear reports whether the year is a 355-day (wuntu) year.
+func (jc JavaneseCalendar) IsLeapYear(year int) bool {
+	return javaneseYearDays[jc.WinduYear(year)-1] == 355
+}
+
+// DaysInMonth returns 30 for the odd months and 29 for the even ones, but 30
+// for Besar in a 355-day year.
+func (JavaneseCalendar) DaysInMonth(year, month int) int {
+	if month < 1 || month > 12 {
+		return 0
+	}
+	return javaneseMonthDays(year, month)
+}
+
+func (JavaneseCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+func (jc JavaneseCalendar) MonthName(year, month int, lang string) string {
+	return monthName(jc, year, month, lang)
+}
+
+func (jc JavaneseCalendar) EraName(era int, lang string) string {
+	return eraName(jc, 0, lang)
+}
+
+// Weton is the day of the Javanese seven-day week combined with the day of
+// the five-day market week (pasaran), such as Senin Legi. Its cycle is 35
+// days.
+type Weton struct {
+	Weekday time.Weekday
+	Pasaran int // 0 (Legi), 1 (Pahing), 2 (Pon), 3 (Wage) or 4 (Kliwon)
+}
+
+// Weton returns the weton of the calendar date of t.
+//
+// Example:
+//
+//	t := time.Date(1945, time.August, 17, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(JavaneseCalendar{}.Weton(t).Name(LangID)) // Output: Jumat Legi
+func (JavaneseCalendar) Weton(t time.Time) Weton {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+	return Weton{Weekday: time.Weekday((jd + 1) % 7), Pasaran: jd % 5}
+}
+
+// Name returns the weekday in lang followed by the pasaran, e.g. "Senin Legi"
+// in Indonesian and "Monday Legi" in English.
+func (w Weton) Name(lang string) string {
+	tr := translate(lang)
+	return tr(fmt.Sprintf("weekday_%d", w.Weekday)) + " " + tr(fmt.Sprintf("javanese_pasaran_%d", w.Pasaran))
+}
+
+// Neptu returns the sum of the values of the weekday and the pasaran, used in
+// Javanese divination (petungan): Senin Legi is 4 + 5 = 9.
+func (w Weton) Neptu() int {
+	weekdays := [7]int{5, 4, 3, 7, 8, 6, 9} // Minggu to Sabtu
+	pasaran := [5]int{5, 9, 7, 4, 8}        // Legi to Kliwon
+	return weekdays[w.Weekday] + pasaran[w.Pasaran]
+}
+
+// javaneseNewYear returns the Julian Day of 1 Sura of the year.
+func javaneseNewYear(year int) int {
+	n := year - 1555
+	jd := javaneseEpoch + floorDiv(n, 8)*windu
+	for i := 0; i < floorMod(n, 8); i++ {
+		jd += javaneseYearDays[i]
+	}
+	// A day is dropped at the start of each kurup: 1627 AJ, then every 120 years
+	if year >= 1627 {
+		jd -= 1 + (year-1627)/120
+	}
+	return jd
+}
+
+// javaneseMonthDays returns the length of a month: 30 days for the odd
+// months, 29 for the even ones, and 30 for Besar in a 355-day year.
+func javaneseMonthDays(year, month int) int {
+	if month%2 == 1 || month == 12 && (JavaneseCalendar{}).IsLeapYear(year) {
+		return 30
+	}
+	return 29
+}
+
+// floorDiv and floorMod divide rounding towards negative infinity, for the
+// years before an epoch.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
@@ -1,41 +1,254 @@
 package regional
 
-import "time"
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/smart"
+)
 
 // JapaneseCalendar implements CalendarSystem for Japan's Gengō (Era) system.
-// Supports modern eras: Meiji, Taisho, Showa, Heisei, and Reiwa.
-type JapaneseCalendar struct{}
+// Supports modern eras: Meiji, Taisho, Showa, Heisei, and Reiwa, and eras
+// added with RegisterJapaneseEra.
+//
+// An era begins on a date in Japan, and t is in the era of its own date, the
+// date the year, month and day are written with: 2019-05-01 in any zone is
+// Reiwa 1/5/1, and 2019-04-30 15:00 UTC, already 1 May in Japan, is Heisei
+// 31/4/30. Pass times in Japan Standard Time for the era in effect in Japan.
+//
+// RegionJP writes dates as in Japanese documents, "令和6年5月1日"; the narrow
+// era names give the short forms, "GGGGGy.M.d" ("R6.5.1"). With Gannen, the
+// first year of an era is written 元 in Japanese ("令和元年5月1日").
+type JapaneseCalendar struct {
+	Gannen bool // Write year 1 of an era as 元 (gannen) in locales that name it
+}
+
+// CLDR indices of the modern eras, as returned by JapaneseCalendar.Era.
+const (
+	JapaneseEraMeiji  = 232
+	JapaneseEraTaisho = 233
+	JapaneseEraShowa  = 234
+	JapaneseEraHeisei = 235
+	JapaneseEraReiwa  = 236
+)
+
+// japanTime is Japan Standard Time, the zone of the era start dates.
+var japanTime = time.FixedZone("JST", 9*60*60)
 
 var (
-	// Era Start Dates
-	dateReiwa  = time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
-	dateHeisei = time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC)
-	dateShowa  = time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC)
-	dateTaisho = time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC)
-	dateMeiji  = time.Date(1868, 1, 25, 0, 0, 0, 0, time.UTC) // Approximate Gregorian start
+	// Era Start Dates, at midnight in Japan
+	dateReiwa  = time.Date(2019, 5, 1, 0, 0, 0, 0, japanTime)
+	dateHeisei = time.Date(1989, 1, 8, 0, 0, 0, 0, japanTime)
+	dateShowa  = time.Date(1926, 12, 25, 0, 0, 0, 0, japanTime)
+	dateTaisho = time.Date(1912, 7, 30, 0, 0, 0, 0, japanTime)
+	dateMeiji  = time.Date(1868, 1, 25, 0, 0, 0, 0, japanTime) // Approximate Gregorian start
 )
 
+// japaneseEra is an era of the Japanese calendar.
+type japaneseEra struct {
+	index int
+	start time.Time
+	name  string
+}
+
+var (
+	// japaneseEras lists the eras from the newest.
+	japaneseEras = []japaneseEra{
+		{JapaneseEraReiwa, dateReiwa, "Reiwa"},
+		{JapaneseEraHeisei, dateHeisei, "Heisei"},
+		{JapaneseEraShowa, dateShowa, "Showa"},
+		{JapaneseEraTaisho, dateTaisho, "Taisho"},
+		{JapaneseEraMeiji, dateMeiji, "Meiji"},
+	}
+	japaneseErasLock sync.RWMutex
+)
+
+// JapaneseEra describes an era for RegisterJapaneseEra.
+type JapaneseEra struct {
+	Start time.Time // First day of the era; only its date is used, as a date in Japan
+	Name  string    // Romanized name, e.g. "Reiwa"
+	Kanji string    // Name in Japanese, e.g. "令和"
+	Abbr  string    // Narrow name of the short forms, e.g. "R"
+}
+
+// RegisterJapaneseEra adds an era beginning after the newest one, so a new
+// era can be used as soon as it is proclaimed, without a library release. It
+// returns the CLDR index of the era, 237 for the one after Reiwa, and adds its
+// names to the "en" and "ja" locales. Name and Kanji are required.
+//
+// Example:
+//
+//	era, err := RegisterJapaneseEra(JapaneseEra{
+//		Start: time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+//		Name:  "Kōwa",
+//		Kanji: "光和",
+//		Abbr:  "K",
+//	})
+func RegisterJapaneseEra(era JapaneseEra) (int, error) {
+	if era.Name == "" || era.Kanji == "" {
+		return 0, fmt.Errorf("japanese era: name and kanji are required")
+	}
+
+	japaneseErasLock.Lock()
+	defer japaneseErasLock.Unlock()
+	newest := japaneseEras[0]
+	y, m, d := era.Start.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, japanTime)
+	if !start.After(newest.start) {
+		return 0, fmt.Errorf("japanese era %s: must begin after %s", era.Name, newest.name)
+	}
+
+	index := newest.index + 1
+	names := map[string]map[string]string{
+		"en": {fmt.Sprintf("japanese_era_%d", index): era.Name},
+		"ja": {fmt.Sprintf("japanese_era_%d", index): era.Kanji},
+	}
+	if era.Abbr != "" {
+		names["en"][fmt.Sprintf("japanese_era_narrow_%d", index)] = era.Abbr
+	}
+	for lang, words := range names {
+		if err := addWords(lang, words); err != nil {
+			return 0, err
+		}
+	}
+
+	eras := []japaneseEra{{index, start, era.Name}}
+	japaneseEras = append(eras, japaneseEras...)
+	return index, nil
+}
+
+// addWords adds words to the dictionary of the registered locale lang.
+func addWords(lang string, words map[string]string) error {
+	loc, ok := smart.LookupLocale(lang)
+	if !ok {
+		loc = smart.Locale{Code: lang}
+	}
+	dict := make(map[string]string, len(loc.Dictionary)+len(words))
+	for key, val := range loc.Dictionary {
+		dict[key] = val
+	}
+	for key, val := range words {
+		dict[key] = val
+	}
+	loc.Dictionary = dict
+	return smart.RegisterLocale(loc)
+}
+
+// japaneseEraOf returns the era of t's date, or era -1 (Seireki) before Meiji.
+func japaneseEraOf(t time.Time) japaneseEra {
+	y, m, d := t.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, japanTime)
+
+	japaneseErasLock.RLock()
+	defer japaneseErasLock.RUnlock()
+	for _, e := range japaneseEras {
+		if !date.Before(e.start) {
+			return e
+		}
+	}
+	return japaneseEra{index: -1, name: "Seireki"}
+}
+
+// Name returns "japanese", the CLDR name of the calendar.
+func (JapaneseCalendar) Name() string {
+	return "japanese"
+}
+
+// Era returns the CLDR index of the era of t's date, or -1 before Meiji,
+// whose years are the Gregorian ones (Seireki).
+func (JapaneseCalendar) Era(t time.Time) int {
+	return japaneseEraOf(t).index
+}
+
 func (jc JapaneseCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
-	m := int(t.Month())
-	d := t.Day()
+	e := japaneseEraOf(t)
+	if e.index == -1 {
+		// Fallback for pre-Meiji
+		return t.Year(), int(t.Month()), t.Day(), e.name
+	}
+	return t.Year() - e.start.Year() + 1, int(t.Month()), t.Day(), e.name
+}
 
-	// Check eras from newest to oldest
-	if !t.Before(dateReiwa) {
-		return t.Year() - 2018, m, d, "Reiwa"
+// ToGregorian converts a year of an era, e.g. Reiwa 6, or a Gregorian year
+// before Meiji (era -1). Dates outside the era, such as Heisei 31/05/01, do
+// not exist.
+func (jc JapaneseCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	if era == -1 {
+		t, err := calendarTime(jc, year, month, day, gregorianDate)
+		if err == nil && jc.Era(t) != -1 {
+			err = fmt.Errorf("japanese date %d/%d/%d is in Meiji or later", year, month, day)
+		}
+		return t, err
 	}
-	if !t.Before(dateHeisei) {
-		return t.Year() - 1988, m, d, "Heisei"
+
+	japaneseErasLock.RLock()
+	eras := japaneseEras
+	japaneseErasLock.RUnlock()
+	for _, e := range eras {
+		if e.index != era {
+			continue
+		}
+		t, err := calendarTime(jc, e.start.Year()+year-1, month, day, gregorianDate)
+		if err == nil && jc.Era(t) != era {
+			err = fmt.Errorf("japanese date %s %d/%d/%d is outside the era", e.name, year, month, day)
+		}
+		return t, err
 	}
-	if !t.Before(dateShowa) {
-		return t.Year() - 1925, m, d, "Showa"
+	return time.Time{}, fmt.Errorf("unknown japanese era %d", era)
+}
+
+// DaysInMonth returns the length of the month of the Gregorian year.
+func (JapaneseCalendar) DaysInMonth(year, month int) int {
+	return gregorianMonthDays(year, month)
+}
+
+func (JapaneseCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// IsLeapYear reports whether the Gregorian year is a leap year.
+func (JapaneseCalendar) IsLeapYear(year int) bool {
+	return isGregorianLeap(year)
+}
+
+// MonthName returns the Gregorian name of the month in lang.
+func (jc JapaneseCalendar) MonthName(year, month int, lang string) string {
+	return monthName(jc, year, month, lang)
+}
+
+// EraName returns the name of the era in lang, e.g. "令和" or "Reiwa" for
+// JapaneseEraReiwa; before Meiji it is the Gregorian era, "西暦" or "AD".
+func (jc JapaneseCalendar) EraName(era int, lang string) string {
+	if era == -1 {
+		return translate(lang)("era_1")
 	}
-	if !t.Before(dateTaisho) {
-		return t.Year() - 1911, m, d, "Taisho"
+	return eraName(jc, era, lang)
+}
+
+func (JapaneseCalendar) monthNameKeys() string {
+	return "" // Gregorian
+}
+
+func (JapaneseCalendar) eras() []int {
+	japaneseErasLock.RLock()
+	defer japaneseErasLock.RUnlock()
+	eras := make([]int, len(japaneseEras))
+	for i, e := range japaneseEras {
+		eras[i] = e.index
 	}
-	if !t.Before(dateMeiji) {
-		return t.Year() - 1867, m, d, "Meiji"
+	return eras
+}
+
+// yearName returns "gannen", the first year of an era, with Gannen.
+func (jc JapaneseCalendar) yearName(year int) string {
+	if jc.Gannen && year == 1 {
+		return "gannen"
 	}
+	return ""
+}
 
-	// Fallback for pre-Meiji
-	return t.Year(), m, d, "Seireki"
+// gregorianDate is the identity conversion of calendars with Gregorian years.
+func gregorianDate(year, month, day int) (int, int, int) {
+	return year, month, day
 }
@@ -0,0 +1,73 @@
+package regional
+
+import "time"
+
+// MinguoCalendar implements CalendarSystem for the Republic of China (Minguo)
+// calendar used in Taiwan. Years are counted from 1912, the founding of the
+// Republic, so 2023 is Minguo 112 ("民國112年"); months and days are the
+// Gregorian ones. Earlier years belong to the era "Before R.O.C." (民國前).
+type MinguoCalendar struct{}
+
+// minguoEpoch is the Gregorian year before Minguo 1.
+const minguoEpoch = 1911
+
+// Name returns "roc", the CLDR name of the calendar.
+func (MinguoCalendar) Name() string {
+	return "roc"
+}
+
+// Era returns 1 from 1912 on (民國) and 0 before (民國前).
+func (MinguoCalendar) Era(t time.Time) int {
+	if t.Year() > minguoEpoch {
+		return 1
+	}
+	return 0
+}
+
+func (mc MinguoCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	if mc.Era(t) == 0 {
+		return minguoEpoch + 1 - t.Year(), int(t.Month()), t.Day(), "Before R.O.C."
+	}
+	return t.Year() - minguoEpoch, int(t.Month()), t.Day(), "Minguo"
+}
+
+// ToGregorian converts a date of era 1 (民國) or of era 0 (民國前), whose
+// years count backwards.
+func (mc MinguoCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	if era == 0 {
+		year = 1 - year
+	}
+	return calendarTime(mc, year, month, day, mc.toGregorian)
+}
+
+func (MinguoCalendar) toGregorian(year, month, day int) (int, int, int) {
+	return year + minguoEpoch, month, day
+}
+
+func (MinguoCalendar) DaysInMonth(year, month int) int {
+	return gregorianMonthDays(year+minguoEpoch, month)
+}
+
+func (MinguoCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// IsLeapYear reports whether the year has 29 February, as its Gregorian year
+// does.
+func (MinguoCalendar) IsLeapYear(year int) bool {
+	return isGregorianLeap(year + minguoEpoch)
+}
+
+// MonthName returns the Gregorian name of the month in lang.
+func (mc MinguoCalendar) MonthName(year, month int, lang string) string {
+	return monthName(mc, year, month, lang)
+}
+
+// EraName returns the name of the era in lang, e.g. "民國" for era 1.
+func (mc MinguoCalendar) EraName(era int, lang string) string {
+	return eraName(mc, era, lang)
+}
+
+func (MinguoCalendar) monthNameKeys() string {
+	return "" // Gregorian
+}
@@ -0,0 +1,94 @@
+package regional
+
+import "time"
+
+// PersianCalendar implements CalendarSystem for the Solar Hijri (Jalali)
+// calendar of Iran and Afghanistan. Years start at the March equinox
+// (Nowruz); the first six months have 31 days, the next five 30 and Esfand 29,
+// or 30 in leap years.
+//
+// Leap years follow the arithmetic 33-year cycle used by CLDR and ICU, which
+// matches the official astronomical calendar from 1178 to 1634 AP
+// (1799-2256 AD).
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionIR, "", PersianCalendar{})) // Output: ۴ دی ۱۴۰۲
+type PersianCalendar struct{}
+
+// persianEpoch is the Julian Day Number of 1 Farvardin 1 AP (19 March 622).
+const persianEpoch = 1948320
+
+// Name returns "persian", the CLDR name of the calendar.
+func (PersianCalendar) Name() string {
+	return "persian"
+}
+
+func (PersianCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	days := julianDay(t.Year(), int(t.Month()), t.Day()) - persianEpoch
+
+	year = 1 + floorDiv(33*days+3, 12053)
+	dayOfYear := days - persianNewYear(year)
+	if dayOfYear < 6*31 {
+		month = dayOfYear / 31
+	} else {
+		month = (dayOfYear - 6) / 30
+	}
+	day = dayOfYear - persianMonthStart(month) + 1
+	return year, month + 1, day, "AP"
+}
+
+func (pc PersianCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(pc, year, month, day, pc.toGregorian)
+}
+
+func (PersianCalendar) toGregorian(year, month, day int) (int, int, int) {
+	return fromJulianDay(persianEpoch + persianNewYear(year) + persianMonthStart(month-1) + day - 1)
+}
+
+// DaysInMonth returns 31 for the first six months, 30 for the next five and
+// 29 for Esfand, or 30 in leap years.
+func (pc PersianCalendar) DaysInMonth(year, month int) int {
+	switch {
+	case month < 1 || month > 12:
+		return 0
+	case month <= 6:
+		return 31
+	case month < 12 || pc.IsLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+func (PersianCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// IsLeapYear reports whether the year has 366 days.
+func (PersianCalendar) IsLeapYear(year int) bool {
+	return persianNewYear(year+1)-persianNewYear(year) == 366
+}
+
+// MonthName returns the name of the month in lang, e.g. "دی" or "Dey" for 10.
+func (pc PersianCalendar) MonthName(year, month int, lang string) string {
+	return monthName(pc, year, month, lang)
+}
+
+func (pc PersianCalendar) EraName(era int, lang string) string {
+	return eraName(pc, 0, lang)
+}
+
+// persianNewYear returns the days from the epoch to 1 Farvardin of year.
+func persianNewYear(year int) int {
+	return 365*(year-1) + floorDiv(8*year+21, 33)
+}
+
+// persianMonthStart returns the day of the year, from 0, on which the
+// zero-based month begins.
+func persianMonthStart(month int) int {
+	if month < 6 {
+		return 31 * month
+	}
+	return 30*month + 6
+}
@@ -0,0 +1,102 @@
+package regional
+
+import "time"
+
+// SakaCalendar implements CalendarSystem for the Indian national calendar,
+// the reformed Saka calendar used by the Government of India alongside the
+// Gregorian one. Its year begins on 1 Chaitra, 22 March (21 March in
+// Gregorian leap years); Chaitra has 30 days (31 in leap years), the next five
+// months 31 and the last six 30, so it stays aligned with the Gregorian year.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionIN, "", SakaCalendar{}))   // Output: 4 Pausa 1945 Saka
+//	fmt.Println(Format(t, RegionIN, "hi", SakaCalendar{})) // Output: 4 पौष 1945 शक
+type SakaCalendar struct{}
+
+// sakaEra is the difference between the Gregorian and Saka years after 1 Chaitra.
+const sakaEra = 78
+
+// Name returns "indian", the CLDR name of the calendar.
+func (SakaCalendar) Name() string {
+	return "indian"
+}
+
+func (SakaCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+	year = t.Year() - sakaEra
+	if jd < sakaNewYear(year) {
+		year--
+	}
+
+	days := jd - sakaNewYear(year)
+	month = 1
+	for days >= sakaMonthDays(year, month) {
+		days -= sakaMonthDays(year, month)
+		month++
+	}
+	return year, month, days + 1, "Saka"
+}
+
+func (sc SakaCalendar) ToGregorian(era, year, month, day int) (time.Time, error) {
+	return calendarTime(sc, year, month, day, sc.toGregorian)
+}
+
+func (SakaCalendar) toGregorian(year, month, day int) (int, int, int) {
+	jd := sakaNewYear(year) + day - 1
+	for m := 1; m < month; m++ {
+		jd += sakaMonthDays(year, m)
+	}
+	return fromJulianDay(jd)
+}
+
+// IsLeapYear reports whether the year has 366 days, as its Gregorian year
+// does.
+func (SakaCalendar) IsLeapYear(year int) bool {
+	return isGregorianLeap(year + sakaEra)
+}
+
+// DaysInMonth returns 30 for Chaitra, or 31 in leap years, 31 for the next
+// five months and 30 for the last six.
+func (SakaCalendar) DaysInMonth(year, month int) int {
+	if month < 1 || month > 12 {
+		return 0
+	}
+	return sakaMonthDays(year, month)
+}
+
+func (SakaCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// MonthName returns the name of the month in lang, e.g. "पौष" or "Pausa" for 10.
+func (sc SakaCalendar) MonthName(year, month int, lang string) string {
+	return monthName(sc, year, month, lang)
+}
+
+func (sc SakaCalendar) EraName(era int, lang string) string {
+	return eraName(sc, 0, lang)
+}
+
+// sakaNewYear returns the Julian Day of 1 Chaitra of the year.
+func sakaNewYear(year int) int {
+	if isGregorianLeap(year + sakaEra) {
+		return julianDay(year+sakaEra, 3, 21)
+	}
+	return julianDay(year+sakaEra, 3, 22)
+}
+
+func sakaMonthDays(year, month int) int {
+	switch {
+	case month == 1 && isGregorianLeap(year+sakaEra):
+		return 31
+	case month >= 2 && month <= 6:
+		return 31
+	}
+	return 30
+}
+
+func isGregorianLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
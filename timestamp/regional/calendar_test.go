@@ -0,0 +1,773 @@
+package regional
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/smart"
+)
+
+// TestPersianCalendar checks conversions against the published Iranian
+// calendar (Nowruz dates and well-known days).
+func TestPersianCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+	}{
+		{"1900-03-20", 1278, 12, 29},
+		{"1900-03-21", 1279, 1, 1}, // Nowruz 1279
+		{"1979-02-11", 1357, 11, 22},
+		{"2020-03-20", 1399, 1, 1},
+		{"2023-12-21", 1402, 9, 30}, // Yalda night
+		{"2023-12-25", 1402, 10, 4},
+		{"2024-03-19", 1402, 12, 29},
+		{"2024-03-20", 1403, 1, 1},
+		{"2025-03-20", 1403, 12, 30}, // Leap year
+		{"2025-03-21", 1404, 1, 1},
+		{"2100-03-21", 1479, 1, 1},
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, _ := PersianCalendar{}.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.gregorian, y, m, d, tt.year, tt.month, tt.day)
+		}
+		if gy, gm, gd := (PersianCalendar{}).toGregorian(tt.year, tt.month, tt.day); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Errorf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", tt.year, tt.month, tt.day, gy, gm, gd, tt.gregorian)
+		}
+	}
+}
+
+// TestPersianCalendar_Range converts every day from 1900 to 2100 back and forth.
+func TestPersianCalendar_Range(t *testing.T) {
+	end := time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC)
+	prevYear, prevMonth, prevDay := 1278, 10, 10 // 31 December 1899
+	for tm := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC); tm.Before(end); tm = tm.AddDate(0, 0, 1) {
+		y, m, d, _ := PersianCalendar{}.Transform(tm)
+		next := d == prevDay+1 && m == prevMonth && y == prevYear ||
+			d == 1 && m == prevMonth+1 && y == prevYear && prevDay >= 29 ||
+			d == 1 && m == 1 && y == prevYear+1 && prevMonth == 12
+		if !next {
+			t.Fatalf("%s: %d/%d/%d does not follow %d/%d/%d", tm.Format("2006-01-02"), y, m, d, prevYear, prevMonth, prevDay)
+		}
+		if gy, gm, gd := (PersianCalendar{}).toGregorian(y, m, d); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Fatalf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", y, m, d, gy, gm, gd, tm.Format("2006-01-02"))
+		}
+		prevYear, prevMonth, prevDay = y, m, d
+	}
+}
+
+// TestPersianCalendar_BeforeEpoch converts the days before 1 Farvardin 1 AP
+// (19 March 622) back and forth, down to 1 January 1 AD.
+func TestPersianCalendar_BeforeEpoch(t *testing.T) {
+	start := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	prevYear, prevMonth, prevDay, _ := PersianCalendar{}.Transform(start)
+	if prevYear != -621 || prevMonth != 10 || prevDay != 11 {
+		t.Errorf("Transform(0001-01-01) = %d/%d/%d, want -621/10/11", prevYear, prevMonth, prevDay)
+	}
+	for tm := start.AddDate(0, 0, 1); tm.Year() < 623; tm = tm.AddDate(0, 0, 1) {
+		y, m, d, _ := PersianCalendar{}.Transform(tm)
+		next := d == prevDay+1 && m == prevMonth && y == prevYear ||
+			d == 1 && m == prevMonth+1 && y == prevYear && prevDay >= 29 ||
+			d == 1 && m == 1 && y == prevYear+1 && prevMonth == 12
+		if !next {
+			t.Fatalf("%s: %d/%d/%d does not follow %d/%d/%d", tm.Format("2006-01-02"), y, m, d, prevYear, prevMonth, prevDay)
+		}
+		if gy, gm, gd := (PersianCalendar{}).toGregorian(y, m, d); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Fatalf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", y, m, d, gy, gm, gd, tm.Format("2006-01-02"))
+		}
+		prevYear, prevMonth, prevDay = y, m, d
+	}
+}
+
+// TestHebrewCalendar checks conversions against published dates of Rosh
+// Hashanah, Purim and Passover.
+func TestHebrewCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+	}{
+		{"1948-05-14", 5708, 9, 5},   // 5 Iyar
+		{"2023-09-15", 5783, 13, 29}, // Last day of Elul
+		{"2023-09-16", 5784, 1, 1},   // Rosh Hashanah
+		{"2023-12-25", 5784, 4, 13},
+		{"2024-02-10", 5784, 6, 1},  // 1 Adar I
+		{"2024-03-24", 5784, 7, 14}, // Purim in Adar II
+		{"2024-04-23", 5784, 8, 15}, // Passover
+		{"2024-10-03", 5785, 1, 1},
+		{"2025-03-14", 5785, 7, 14}, // Purim in a common year
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, _ := HebrewCalendar{}.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.gregorian, y, m, d, tt.year, tt.month, tt.day)
+		}
+		if gy, gm, gd := (HebrewCalendar{}).toGregorian(tt.year, tt.month, tt.day); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Errorf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", tt.year, tt.month, tt.day, gy, gm, gd, tt.gregorian)
+		}
+	}
+}
+
+// TestHebrewCalendar_YearLengths checks that every year from 1900 to 2100 is
+// deficient, regular or complete, and starts on an allowed weekday.
+func TestHebrewCalendar_YearLengths(t *testing.T) {
+	for year := 5660; year <= 5861; year++ {
+		days := hebrewNewYear(year+1) - hebrewNewYear(year)
+		valid := map[int]bool{353: true, 354: true, 355: true}
+		if isHebrewLeap(year) {
+			valid = map[int]bool{383: true, 384: true, 385: true}
+		}
+		if !valid[days] {
+			t.Errorf("year %d has %d days", year, days)
+		}
+		// Lo ADU Rosh: 1 Tishri is never a Sunday, Wednesday or Friday
+		y, m, d := fromJulianDay(hebrewNewYear(year))
+		if wd := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Weekday(); wd == time.Sunday || wd == time.Wednesday || wd == time.Friday {
+			t.Errorf("1 Tishri %d falls on %s", year, wd)
+		}
+	}
+}
+
+// TestChineseCalendar checks conversions against the calendar published by
+// the Purple Mountain Observatory, including the leap months of recent years.
+func TestChineseCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+		leap             bool
+	}{
+		{"1900-01-31", 1900, 1, 1, false},
+		{"1984-02-02", 1984, 1, 1, false},
+		{"2017-07-23", 2017, 6, 1, true},
+		{"2020-05-23", 2020, 4, 1, true},
+		{"2020-06-20", 2020, 4, 29, true},
+		{"2020-06-21", 2020, 5, 1, false},
+		{"2023-01-21", 2022, 12, 30, false}, // New Year's Eve
+		{"2023-03-22", 2023, 2, 1, true},
+		{"2023-12-25", 2023, 11, 13, false},
+		{"2024-02-10", 2024, 1, 1, false},
+		{"2024-09-17", 2024, 8, 15, false}, // Mid-Autumn Festival
+		{"2025-07-25", 2025, 6, 1, true},
+		{"2033-12-22", 2033, 11, 1, true},
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		d := ChineseCalendar{}.Date(tm)
+		if d.Year != tt.year || d.Month != tt.month || d.Day != tt.day || d.Leap != tt.leap {
+			t.Errorf("Date(%s) = %d/%d/%d leap %v, want %d/%d/%d leap %v", tt.gregorian, d.Year, d.Month, d.Day, d.Leap, tt.year, tt.month, tt.day, tt.leap)
+		}
+	}
+}
+
+func TestChineseDate_Names(t *testing.T) {
+	d := ChineseCalendar{}.Date(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC))
+	if d.Cycle != 41 {
+		t.Errorf("Cycle = %d, want 41", d.Cycle)
+	}
+	tests := []struct {
+		got, expected string
+	}{
+		{d.YearName("zh"), "甲辰"},
+		{d.YearName("en"), "jia-chen"},
+		{d.Zodiac("zh"), "龙"},
+		{d.Zodiac("en"), "Dragon"},
+		{sexagenary(1), "甲子"},
+		{sexagenary(60), "癸亥"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("got %q, want %q", tt.got, tt.expected)
+		}
+	}
+}
+
+func TestLunarNewYear(t *testing.T) {
+	tests := map[int]string{
+		1900: "1900-01-31",
+		1920: "1920-02-20",
+		1966: "1966-01-21",
+		1985: "1985-02-20",
+		2000: "2000-02-05",
+		2020: "2020-01-25",
+		2023: "2023-01-22",
+		2024: "2024-02-10",
+		2025: "2025-01-29",
+		2026: "2026-02-17",
+		2033: "2033-01-31",
+		2057: "2057-02-04",
+		2058: "2058-01-24",
+		2100: "2100-02-09",
+	}
+
+	for year, expected := range tests {
+		if got := LunarNewYear(year).Format("2006-01-02"); got != expected {
+			t.Errorf("LunarNewYear(%d) = %s, want %s", year, got, expected)
+		}
+	}
+}
+
+func TestSolarTerms(t *testing.T) {
+	beijing := time.FixedZone("CST", 8*3600)
+	terms := SolarTerms(2024)
+	if len(terms) != 24 {
+		t.Fatalf("SolarTerms(2024) has %d terms, want 24", len(terms))
+	}
+
+	tests := []struct {
+		i        int
+		name     string
+		expected string // Beijing time, from the Hong Kong Observatory
+	}{
+		{0, "小寒", "2024-01-06 04:49"},
+		{2, "立春", "2024-02-04 16:27"},
+		{5, "春分", "2024-03-20 11:06"},
+		{11, "夏至", "2024-06-21 04:51"},
+		{17, "秋分", "2024-09-22 20:44"},
+		{23, "冬至", "2024-12-21 17:21"},
+	}
+	for _, tt := range tests {
+		term := terms[tt.i]
+		if got := term.Name("zh"); got != tt.name {
+			t.Errorf("terms[%d].Name = %q, want %q", tt.i, got, tt.name)
+		}
+		// Within two minutes of the published time
+		want, _ := time.ParseInLocation("2006-01-02 15:04", tt.expected, beijing)
+		if diff := term.Time.Sub(want); diff < -2*time.Minute || diff > 2*time.Minute {
+			t.Errorf("%s at %s, want %s", tt.name, term.Time.In(beijing).Format("2006-01-02 15:04"), tt.expected)
+		}
+	}
+	if terms[2].Longitude() != 315 || terms[5].Longitude() != 0 {
+		t.Errorf("Longitude() = %d, %d, want 315, 0", terms[2].Longitude(), terms[5].Longitude())
+	}
+}
+
+// TestChineseCalendar_OutsideRange checks that dates far from 1900-2100, down
+// to the zero time.Time, are still computed, and in the right season.
+func TestChineseCalendar_OutsideRange(t *testing.T) {
+	if got := LunarNewYear(1600).Format("2006-01-02"); got != "1600-02-15" {
+		t.Errorf("LunarNewYear(1600) = %s, want 1600-02-15", got)
+	}
+	for _, year := range []int{1, 500, 1000, 1600, 1850, 2500} {
+		if newYear := LunarNewYear(year); newYear.Year() != year || newYear.Month() < time.January || newYear.Month() > time.February {
+			t.Errorf("LunarNewYear(%d) = %s, want January or February", year, newYear.Format("2006-01-02"))
+		}
+		if terms := SolarTerms(year); len(terms) != 24 {
+			t.Errorf("SolarTerms(%d) has %d terms, want 24", year, len(terms))
+		}
+	}
+
+	if got := Format(time.Time{}, RegionCN, "", ChineseCalendar{}); got != "庚申0年11月21日" {
+		t.Errorf("Format(zero time) = %q, want 庚申0年11月21日", got)
+	}
+}
+
+// TestJavaneseCalendar checks the start of each kurup and the 1 Sura dates
+// announced for recent years.
+func TestJavaneseCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+		weton            string
+		windu            string
+	}{
+		{"1633-07-08", 1555, 1, 1, "Jumat Legi", "Alip"},   // Epoch
+		{"1703-05-17", 1627, 1, 1, "Kamis Kliwon", "Alip"}, // Kurup Amiswon
+		{"1819-10-20", 1747, 1, 1, "Rabu Wage", "Alip"},    // Kurup Aboge
+		{"1936-03-24", 1867, 1, 1, "Selasa Pon", "Alip"},   // Kurup Asapon
+		{"1945-08-17", 1876, 9, 9, "Jumat Legi", "Ehe"},    // Proclamation, 9 Ramadan 1364
+		{"2023-07-20", 1957, 1, 1, "Kamis Pahing", "Jimawal"},
+		{"2024-07-07", 1957, 12, 29, "Minggu Kliwon", "Jimawal"},
+		{"2024-07-08", 1958, 1, 1, "Senin Legi", "Je"},
+		{"2025-06-27", 1959, 1, 1, "Jumat Kliwon", "Dal"},
+		{"2052-08-26", 1987, 1, 1, "Senin Pahing", "Alip"}, // Kurup Anenhing
+	}
+
+	jc := JavaneseCalendar{}
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, _ := jc.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.gregorian, y, m, d, tt.year, tt.month, tt.day)
+		}
+		if got := jc.Weton(tm).Name(LangID); got != tt.weton {
+			t.Errorf("Weton(%s) = %q, want %q", tt.gregorian, got, tt.weton)
+		}
+		if got := jc.WinduYearName(y, LangID); got != tt.windu {
+			t.Errorf("WinduYearName(%d) = %q, want %q", y, got, tt.windu)
+		}
+	}
+}
+
+func TestJavaneseCalendar_RoundTrip(t *testing.T) {
+	jc := JavaneseCalendar{}
+	for jd := julianDay(1600, 1, 1); jd < julianDay(2200, 1, 1); jd++ {
+		y, m, d := fromJulianDay(jd)
+		jy, jm, jd2, _ := jc.Transform(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC))
+		if gy, gm, gd := jc.toGregorian(jy, jm, jd2); gy != y || gm != m || gd != d {
+			t.Fatalf("%d-%02d-%02d -> %d/%d/%d -> %d-%02d-%02d", y, m, d, jy, jm, jd2, gy, gm, gd)
+		}
+	}
+}
+
+func TestWeton(t *testing.T) {
+	w := JavaneseCalendar{}.Weton(time.Date(2024, 7, 8, 0, 0, 0, 0, time.UTC))
+	if got := w.Name(LangEN); got != "Monday Legi" {
+		t.Errorf("Name(en) = %q, want %q", got, "Monday Legi")
+	}
+	if got := w.Neptu(); got != 9 {
+		t.Errorf("Neptu() = %d, want 9", got)
+	}
+	// The weton repeats every 35 days
+	later := JavaneseCalendar{}.Weton(time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC))
+	if later != w {
+		t.Errorf("Weton 35 days later = %+v, want %+v", later, w)
+	}
+}
+
+// TestSakaCalendar checks conversions against the dates of the Gazette of
+// India, which carry both calendars.
+func TestSakaCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+	}{
+		{"1957-03-22", 1879, 1, 1}, // Adoption of the national calendar
+		{"2023-03-22", 1945, 1, 1},
+		{"2023-12-25", 1945, 10, 4},
+		{"2024-01-26", 1945, 11, 6}, // Republic Day
+		{"2024-03-20", 1945, 12, 30},
+		{"2024-03-21", 1946, 1, 1}, // Leap year: 1 Chaitra on 21 March
+		{"2024-04-20", 1946, 1, 31},
+		{"2024-08-15", 1946, 5, 24}, // Independence Day
+		{"2025-03-21", 1946, 12, 30},
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, _ := SakaCalendar{}.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.gregorian, y, m, d, tt.year, tt.month, tt.day)
+		}
+		if gy, gm, gd := (SakaCalendar{}).toGregorian(tt.year, tt.month, tt.day); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Errorf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", tt.year, tt.month, tt.day, gy, gm, gd, tt.gregorian)
+		}
+	}
+}
+
+func TestEthiopianCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+	}{
+		{"2023-09-11", 2015, 13, 6}, // Pagume 6 in the year before a Gregorian leap year
+		{"2023-09-12", 2016, 1, 1},  // Enkutatash
+		{"2023-09-28", 2016, 1, 17}, // Meskel
+		{"2024-01-07", 2016, 4, 28}, // Genna
+		{"2024-01-19", 2016, 5, 10}, // Timket
+		{"2024-09-10", 2016, 13, 5},
+		{"2024-09-11", 2017, 1, 1},
+		{"0008-08-27", 1, 1, 1},
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, era := EthiopianCalendar{}.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day || era != "EC" {
+			t.Errorf("Transform(%s) = %d/%d/%d %s, want %d/%d/%d EC", tt.gregorian, y, m, d, era, tt.year, tt.month, tt.day)
+		}
+		if gy, gm, gd := (EthiopianCalendar{}).toGregorian(tt.year, tt.month, tt.day); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Errorf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", tt.year, tt.month, tt.day, gy, gm, gd, tt.gregorian)
+		}
+	}
+
+	// The day before 1 Meskerem 1 is in Amete Alem
+	tm := time.Date(8, 8, 26, 0, 0, 0, 0, time.UTC)
+	if y, m, d, era := (EthiopianCalendar{}).Transform(tm); y != 5500 || m != 13 || d != 5 || era != "AA" {
+		t.Errorf("Transform(0008-08-26) = %d/%d/%d %s, want 5500/13/5 AA", y, m, d, era)
+	}
+	if !(EthiopianCalendar{}).IsLeapYear(2015) || (EthiopianCalendar{}).IsLeapYear(2016) {
+		t.Error("IsLeapYear: want 2015 leap and 2016 common")
+	}
+}
+
+func TestCopticCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian        string
+		year, month, day int
+	}{
+		{"2023-09-11", 1739, 13, 6}, // Nasie 6
+		{"2023-09-12", 1740, 1, 1},  // Nayrouz
+		{"2024-01-07", 1740, 4, 28}, // Coptic Christmas
+		{"2024-09-11", 1741, 1, 1},
+		{"2025-09-11", 1742, 1, 1},
+		{"0284-08-29", 1, 1, 1}, // 29 August 284 Julian
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		y, m, d, _ := CopticCalendar{}.Transform(tm)
+		if y != tt.year || m != tt.month || d != tt.day {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.gregorian, y, m, d, tt.year, tt.month, tt.day)
+		}
+		if gy, gm, gd := (CopticCalendar{}).toGregorian(tt.year, tt.month, tt.day); gy != tm.Year() || gm != int(tm.Month()) || gd != tm.Day() {
+			t.Errorf("toGregorian(%d/%d/%d) = %d-%d-%d, want %s", tt.year, tt.month, tt.day, gy, gm, gd, tt.gregorian)
+		}
+	}
+
+	tm := time.Date(284, 8, 28, 0, 0, 0, 0, time.UTC)
+	if y, _, _, era := (CopticCalendar{}).Transform(tm); y != 1 || era != "BD" || (CopticCalendar{}).Era(tm) != 0 {
+		t.Errorf("Transform(0284-08-28) = %d %s, want 1 BD", y, era)
+	}
+}
+
+// TestVikramSamvatCalendar checks festivals and adhik months published by
+// drik panchangs for Ujjain.
+func TestVikramSamvatCalendar(t *testing.T) {
+	tests := []struct {
+		gregorian string
+		want      VikramDate
+	}{
+		{"2023-07-18", VikramDate{2080, 5, true, 1}},    // Adhik Shravana begins
+		{"2023-08-17", VikramDate{2080, 5, false, 1}},   // Nija Shravana begins
+		{"2024-01-01", VikramDate{2080, 9, false, 20}},  // Margashirsha Krishna 5
+		{"2024-03-25", VikramDate{2080, 12, false, 15}}, // Holi, Phalguna Purnima
+		{"2024-04-08", VikramDate{2080, 12, false, 30}},
+		{"2024-04-09", VikramDate{2081, 1, false, 1}},  // Chaitra Shukla Pratipada
+		{"2024-04-17", VikramDate{2081, 1, false, 9}},  // Ram Navami
+		{"2024-08-26", VikramDate{2081, 5, false, 23}}, // Janmashtami
+		{"2024-11-01", VikramDate{2081, 7, false, 30}}, // Diwali amavasya
+		{"2024-11-02", VikramDate{2081, 8, false, 1}},  // Govardhan Puja
+		{"2025-03-30", VikramDate{2082, 1, false, 1}},
+		{"2026-05-17", VikramDate{2083, 3, true, 1}}, // Adhik Jyeshtha
+	}
+
+	for _, tt := range tests {
+		tm, _ := time.Parse("2006-01-02", tt.gregorian)
+		if got := (VikramSamvatCalendar{}).Date(tm); got != tt.want {
+			t.Errorf("Date(%s) = %+v, want %+v", tt.gregorian, got, tt.want)
+		}
+	}
+
+	d := VikramSamvatCalendar{}.Date(time.Date(2024, 8, 26, 0, 0, 0, 0, time.UTC))
+	if got := d.Paksha(LangEN); got != "Krishna" {
+		t.Errorf("Paksha() = %q, want %q", got, "Krishna")
+	}
+}
+
+// TestVikramSamvatCalendar_OutsideRange checks that dates far from 1900-2100,
+// down to the zero time.Time, are still computed: each year begins with
+// Chaitra around March or April.
+func TestVikramSamvatCalendar_OutsideRange(t *testing.T) {
+	if got := Format(time.Time{}, RegionIN, "", VikramSamvatCalendar{}); got != "21 Pausa 57 VS" {
+		t.Errorf("Format(zero time) = %q, want 21 Pausa 57 VS", got)
+	}
+
+	for _, year := range []int{1, 500, 1000, 1600, 2500} {
+		var first time.Time
+		for tm := time.Date(year, 3, 1, 0, 0, 0, 0, time.UTC); tm.Month() < time.May; tm = tm.AddDate(0, 0, 1) {
+			if d := (VikramSamvatCalendar{}).Date(tm); d.Year == year+vikramEra && d.Month == 1 {
+				first = tm
+				break
+			}
+		}
+		if first.IsZero() {
+			t.Errorf("no Chaitra of %d VS in March or April %d", year+vikramEra, year)
+		}
+	}
+}
+
+// TestBidirectionalCalendar_RoundTrip converts every day from 1950 to 2050
+// back and forth in each calendar.
+func TestBidirectionalCalendar_RoundTrip(t *testing.T) {
+	calendars := []BidirectionalCalendar{
+		BuddhistCalendar{}, CopticCalendar{}, EthiopianCalendar{}, HebrewCalendar{}, HijriCalendar{},
+		HijriCalendar{Variant: HijriTypeIII, Adjustment: 1}, HijriCalendar{Variant: HijriUmmAlQura},
+		JapaneseCalendar{}, JavaneseCalendar{}, MinguoCalendar{}, PersianCalendar{}, SakaCalendar{},
+	}
+	end := time.Date(2051, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, cal := range calendars {
+		for tm := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC); tm.Before(end); tm = tm.AddDate(0, 0, 1) {
+			y, m, d, _ := cal.Transform(tm)
+			era := 0
+			if eras, ok := cal.(EraCalendar); ok {
+				era = eras.Era(tm)
+			}
+			got, err := cal.ToGregorian(era, y, m, d)
+			if err != nil || !got.Equal(tm) {
+				t.Fatalf("%s: ToGregorian(%d, %d/%d/%d) = %v, %v, want %s", cal.Name(), era, y, m, d, got, err, tm.Format("2006-01-02"))
+			}
+
+			year := y
+			if _, ok := cal.(JapaneseCalendar); ok {
+				year = tm.Year()
+			}
+			if days := cal.DaysInMonth(year, m); d > days {
+				t.Fatalf("%s: %d/%d/%d beyond DaysInMonth %d", cal.Name(), y, m, d, days)
+			}
+		}
+	}
+}
+
+func TestBidirectionalCalendar_Metadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		cal      BidirectionalCalendar
+		year     int
+		month    int
+		days     int
+		months   int
+		leap     bool
+		lang     string
+		monthStr string
+		era      int
+		eraStr   string
+	}{
+		{"Hijri Ramadan", HijriCalendar{}, 1445, 9, 30, 12, true, LangID, "Ramadan", 0, "H"},
+		{"Hijri Syakban", HijriCalendar{}, 1445, 8, 29, 12, true, "ar", "شعبان", 0, "هـ"},
+		{"Hijri Zulhijah common", HijriCalendar{}, 1446, 12, 29, 12, false, LangEN, "Dhuʻl-Hijjah", 0, "AH"},
+		{"Umm al-Qura Ramadan", HijriCalendar{Variant: HijriUmmAlQura}, 1445, 9, 30, 12, false, "ar", "رمضان", 0, "هـ"},
+		{"Umm al-Qura Jumada II", HijriCalendar{Variant: HijriUmmAlQura}, 1445, 6, 30, 12, false, "ms", "Jamadilakhir", 0, "H"},
+		{"Hijri Jumada II in Indonesian", HijriCalendar{Variant: HijriTypeIV}, 1445, 6, 29, 12, true, LangID, "Jumadil Akhir", 0, "H"},
+		{"Japanese February", JapaneseCalendar{}, 2024, 2, 29, 12, true, "ja", "2月", JapaneseEraReiwa, "令和"},
+		{"Japanese English", JapaneseCalendar{}, 2023, 2, 28, 12, false, LangEN, "February", JapaneseEraReiwa, "Reiwa"},
+		{"Hebrew Adar II", HebrewCalendar{}, 5784, 7, 29, 13, true, LangEN, "Adar II", 0, "AM"},
+		{"Hebrew no Adar I", HebrewCalendar{}, 5785, 6, 0, 12, false, LangEN, "Adar I", 0, "AM"},
+		{"Persian Esfand", PersianCalendar{}, 1403, 12, 30, 12, true, "fa", "اسفند", 0, "ه‍.ش."},
+		{"Ethiopian Pagume", EthiopianCalendar{}, 2015, 13, 6, 13, true, "am", "ጳጉሜን", 1, "ዓ/ም"},
+		{"Coptic Nasie", CopticCalendar{}, 1740, 13, 5, 13, false, LangEN, "Nasie", 1, "AM"},
+		{"Minguo February", MinguoCalendar{}, 113, 2, 29, 12, true, "zh-Hant", "2月", 1, "民國"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.DaysInMonth(tt.year, tt.month); got != tt.days {
+				t.Errorf("DaysInMonth(%d, %d) = %d, want %d", tt.year, tt.month, got, tt.days)
+			}
+			if got := tt.cal.MonthsInYear(tt.year); got != tt.months {
+				t.Errorf("MonthsInYear(%d) = %d, want %d", tt.year, got, tt.months)
+			}
+			if got := tt.cal.IsLeapYear(tt.year); got != tt.leap {
+				t.Errorf("IsLeapYear(%d) = %v, want %v", tt.year, got, tt.leap)
+			}
+			if got := tt.cal.MonthName(tt.year, tt.month, tt.lang); got != tt.monthStr {
+				t.Errorf("MonthName(%d, %d, %q) = %q, want %q", tt.year, tt.month, tt.lang, got, tt.monthStr)
+			}
+			if got := tt.cal.EraName(tt.era, tt.lang); got != tt.eraStr {
+				t.Errorf("EraName(%d, %q) = %q, want %q", tt.era, tt.lang, got, tt.eraStr)
+			}
+		})
+	}
+}
+
+// TestHijriCalendar_Variants checks month starts that differ between the
+// tabular leap-year cycles, the Umm al-Qura tables and adjusted dates.
+func TestHijriCalendar_Variants(t *testing.T) {
+	tests := []struct {
+		name             string
+		cal              HijriCalendar
+		year, month, day int
+		want             string
+	}{
+		{"Type II 1 Ramadan 1445", HijriCalendar{}, 1445, 9, 1, "2024-03-11"},
+		{"Type I year 15 is leap", HijriCalendar{Variant: HijriTypeI}, 1426, 1, 1, "2005-02-11"},
+		{"Type II year 16 is leap", HijriCalendar{Variant: HijriTypeII}, 1426, 1, 1, "2005-02-10"},
+		{"Type II year 7 is leap", HijriCalendar{Variant: HijriTypeII}, 1448, 1, 1, "2026-06-17"},
+		{"Type III year 8 is leap", HijriCalendar{Variant: HijriTypeIII}, 1448, 1, 1, "2026-06-16"},
+		{"Type III year 10 is leap", HijriCalendar{Variant: HijriTypeIII}, 1451, 1, 1, "2029-05-15"},
+		{"Type IV year 11 is leap", HijriCalendar{Variant: HijriTypeIV}, 1451, 1, 1, "2029-05-14"},
+		{"Umm al-Qura 1 Ramadan 1444", HijriCalendar{Variant: HijriUmmAlQura}, 1444, 9, 1, "2023-03-23"},
+		{"Umm al-Qura 1 Shawwal 1445", HijriCalendar{Variant: HijriUmmAlQura}, 1445, 10, 1, "2024-04-10"},
+		{"Umm al-Qura 1 Muharram 1300", HijriCalendar{Variant: HijriUmmAlQura}, 1300, 1, 1, "1882-11-12"},
+		{"Umm al-Qura 1 Muharram 1601", HijriCalendar{Variant: HijriUmmAlQura}, 1601, 1, 1, "2174-11-26"},
+		{"One day later", HijriCalendar{Adjustment: 1}, 1445, 9, 1, "2024-03-10"},
+		{"One day earlier", HijriCalendar{Adjustment: -1}, 1445, 9, 1, "2024-03-12"},
+		{"Adjusted Umm al-Qura", HijriCalendar{Variant: HijriUmmAlQura, Adjustment: -2}, 1445, 10, 1, "2024-04-12"},
+		{"Indonesia 1 Ramadan 1445", HijriCalendar{Variant: HijriAstronomical}, 1445, 9, 1, "2024-03-12"},
+		{"Indonesia 1 Shawwal 1444", HijriCalendar{Variant: HijriAstronomical}, 1444, 10, 1, "2023-04-22"},
+		{"Indonesia 1 Ramadan 1446 seen in Aceh", HijriCalendar{Variant: HijriAstronomical}, 1446, 9, 1, "2025-03-01"},
+		{"Indonesia 10 Dhu al-Hijjah 1446", HijriCalendar{Variant: HijriAstronomical}, 1446, 12, 10, "2025-06-06"},
+		{"Muhammadiyah 1 Ramadan 1445", HijriCalendar{Variant: HijriAstronomical, Observer: yogyakarta}, 1445, 9, 1, "2024-03-11"},
+		{"Muhammadiyah 1 Shawwal 1444", HijriCalendar{Variant: HijriAstronomical, Observer: yogyakarta}, 1444, 10, 1, "2023-04-21"},
+		{"Muhammadiyah 10 Dhu al-Hijjah 1444", HijriCalendar{Variant: HijriAstronomical, Observer: yogyakarta}, 1444, 12, 10, "2023-06-28"},
+		{"Malaysia 1 Ramadan 1446", HijriCalendar{Variant: HijriAstronomical, Observer: kualaLumpur}, 1446, 9, 1, "2025-03-02"},
+		{"Malaysia 1 Shawwal 1446", HijriCalendar{Variant: HijriAstronomical, Observer: kualaLumpur}, 1446, 10, 1, "2025-03-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cal.ToGregorian(0, tt.year, tt.month, tt.day)
+			if err != nil || got.Format("2006-01-02") != tt.want {
+				t.Fatalf("ToGregorian(%d/%d/%d) = %v, %v, want %s", tt.year, tt.month, tt.day, got, err, tt.want)
+			}
+			if y, m, d, _ := tt.cal.Transform(got); y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tt.want, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+var (
+	yogyakarta  = &HilalObserver{Latitude: -7.80, Longitude: 110.36, Criterion: WujudulHilal}
+	kualaLumpur = &HilalObserver{Latitude: 3.14, Longitude: 101.69}
+)
+
+// TestHijriCalendar_Astronomical checks the observations given to the
+// criterion and the length of the astronomical months.
+func TestHijriCalendar_Astronomical(t *testing.T) {
+	var observations []HilalObservation
+	observer := &HilalObserver{Latitude: -6.1754, Longitude: 106.8272, Criterion: func(o HilalObservation) bool {
+		observations = append(observations, o)
+		return MABIMS(o)
+	}}
+	hc := HijriCalendar{Variant: HijriAstronomical, Observer: observer}
+	if y, m, d := fromJulianDay(hc.monthStart(12*1444 + 8)); y != 2024 || m != 3 || d != 12 {
+		t.Errorf("1 Ramadan 1445 from Jakarta = %d-%02d-%02d, want 2024-03-12", y, m, d)
+	}
+
+	// Sunset in Jakarta on 10 March 2024, nine hours after the new moon
+	o := observations[0]
+	if o.Sunset.Format("2006-01-02 15:04") != "2024-03-10 11:07" || o.Conjunction.Format("15:04") != "09:00" {
+		t.Errorf("observation at %s of the new moon at %s", o.Sunset, o.Conjunction)
+	}
+	if o.Age < 2*time.Hour || o.Altitude < 0 || o.Altitude > 3 || o.Elongation > 6.4 || MABIMS(o) || !WujudulHilal(o) {
+		t.Errorf("observation %+v", o)
+	}
+	if len(observations) != 2 || !MABIMS(observations[1]) {
+		t.Errorf("observations %+v, want the crescent seen on the second evening", observations)
+	}
+
+	for _, hc := range []HijriCalendar{{Variant: HijriAstronomical}, {Variant: HijriAstronomical, Observer: yogyakarta}} {
+		for year := 1400; year <= 1500; year++ {
+			for month := 1; month <= 12; month++ {
+				if days := hc.DaysInMonth(year, month); days != 29 && days != 30 {
+					t.Fatalf("DaysInMonth(%d, %d) = %d", year, month, days)
+				}
+			}
+		}
+	}
+
+	// Round trip, over fewer years than the faster calendars
+	hc = HijriCalendar{Variant: HijriAstronomical, Adjustment: -1}
+	end := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	for tm := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC); tm.Before(end); tm = tm.AddDate(0, 0, 1) {
+		y, m, d, _ := hc.Transform(tm)
+		if got, err := hc.ToGregorian(0, y, m, d); err != nil || !got.Equal(tm) {
+			t.Fatalf("ToGregorian(%d/%d/%d) = %v, %v, want %s", y, m, d, got, err, tm.Format("2006-01-02"))
+		}
+	}
+}
+
+// TestHijriCalendar_AstronomicalOutsideRange checks that the astronomical
+// variant uses Type II months outside 1318 to 1523 AH, and joins them.
+func TestHijriCalendar_AstronomicalOutsideRange(t *testing.T) {
+	hc := HijriCalendar{Variant: HijriAstronomical}
+	for _, tm := range []time.Time{{}, time.Date(800, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2500, 6, 1, 0, 0, 0, 0, time.UTC)} {
+		y, m, d, _ := hc.Transform(tm)
+		wy, wm, wd, _ := HijriCalendar{}.Transform(tm)
+		if y != wy || m != wm || d != wd {
+			t.Errorf("Transform(%s) = %d/%d/%d, want %d/%d/%d", tm.Format("2006-01-02"), y, m, d, wy, wm, wd)
+		}
+	}
+
+	// Round trip across both ends of the range
+	for _, start := range []time.Time{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2099, 10, 1, 0, 0, 0, 0, time.UTC)} {
+		for tm := start; tm.Before(start.AddDate(1, 0, 0)); tm = tm.AddDate(0, 0, 1) {
+			y, m, d, _ := hc.Transform(tm)
+			if got, err := hc.ToGregorian(0, y, m, d); err != nil || !got.Equal(tm) {
+				t.Fatalf("ToGregorian(%d/%d/%d) = %v, %v, want %s", y, m, d, got, err, tm.Format("2006-01-02"))
+			}
+			if days := hc.DaysInMonth(y, m); d > days || days < 29 || days > 30 {
+				t.Fatalf("%s is %d/%d/%d, in a month of %d days", tm.Format("2006-01-02"), y, m, d, days)
+			}
+		}
+	}
+}
+
+func TestBidirectionalCalendar_ToGregorian(t *testing.T) {
+	tests := []struct {
+		name             string
+		cal              BidirectionalCalendar
+		era              int
+		year, month, day int
+		want             string // "" for an error
+	}{
+		{"Reiwa 6", JapaneseCalendar{}, JapaneseEraReiwa, 6, 5, 1, "2024-05-01"},
+		{"Reiwa 1", JapaneseCalendar{}, JapaneseEraReiwa, 1, 5, 1, "2019-05-01"},
+		{"Before Reiwa", JapaneseCalendar{}, JapaneseEraReiwa, 1, 4, 30, ""},
+		{"Heisei 31", JapaneseCalendar{}, JapaneseEraHeisei, 31, 4, 30, "2019-04-30"},
+		{"After Heisei", JapaneseCalendar{}, JapaneseEraHeisei, 31, 5, 1, ""},
+		{"Showa 64", JapaneseCalendar{}, JapaneseEraShowa, 64, 1, 7, "1989-01-07"},
+		{"Before Meiji", JapaneseCalendar{}, -1, 1850, 1, 1, "1850-01-01"},
+		{"Unknown era", JapaneseCalendar{}, 237, 1, 1, 1, ""},
+		{"Japanese 30 February", JapaneseCalendar{}, JapaneseEraReiwa, 6, 2, 30, ""},
+		{"1 Ramadan 1445", HijriCalendar{}, 0, 1445, 9, 1, "2024-03-11"},
+		{"30 Zulhijah 1445", HijriCalendar{}, 0, 1445, 12, 30, "2024-07-07"},
+		{"30 Zulhijah 1446", HijriCalendar{}, 0, 1446, 12, 30, ""},
+		{"Hijri month 13", HijriCalendar{}, 0, 1445, 13, 1, ""},
+		{"Before R.O.C. 1", MinguoCalendar{}, 0, 1, 12, 31, "1911-12-31"},
+		{"Amete Alem 5500", EthiopianCalendar{}, 0, 5500, 13, 5, "0008-08-26"},
+		{"Coptic BD 1", CopticCalendar{}, 0, 1, 13, 5, "0284-08-28"},
+		{"Adar I in a common year", HebrewCalendar{}, 0, 5785, 6, 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cal.ToGregorian(tt.era, tt.year, tt.month, tt.day)
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("ToGregorian(%d, %d/%d/%d) = %v, want error", tt.era, tt.year, tt.month, tt.day, got)
+				}
+				return
+			}
+			if err != nil || got.Format("2006-01-02") != tt.want {
+				t.Errorf("ToGregorian(%d, %d/%d/%d) = %v, %v, want %s", tt.era, tt.year, tt.month, tt.day, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterJapaneseEra(t *testing.T) {
+	eras := japaneseEras
+	en, _ := smart.LookupLocale("en")
+	ja, _ := smart.LookupLocale("ja")
+	t.Cleanup(func() {
+		japaneseEras = eras
+		smart.RegisterLocale(en)
+		smart.RegisterLocale(ja)
+	})
+
+	if _, err := RegisterJapaneseEra(JapaneseEra{Start: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Name: "Early", Kanji: "早"}); err == nil {
+		t.Error("RegisterJapaneseEra accepted an era beginning with Reiwa")
+	}
+	if _, err := RegisterJapaneseEra(JapaneseEra{Start: time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)}); err == nil {
+		t.Error("RegisterJapaneseEra accepted an era without names")
+	}
+
+	era, err := RegisterJapaneseEra(JapaneseEra{Start: time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Kōwa", Kanji: "光和", Abbr: "K"})
+	if err != nil || era != 237 {
+		t.Fatalf("RegisterJapaneseEra = %d, %v, want 237", era, err)
+	}
+
+	jc := JapaneseCalendar{Gannen: true}
+	tm := time.Date(2041, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := Format(tm, RegionJP, "", jc); got != "光和2年3月1日" {
+		t.Errorf("Format = %q, want 光和2年3月1日", got)
+	}
+	if got := FormatCalendarPattern(tm, "GGGGGy.M.d", RegionJP, LangEN, jc); got != "K2.3.1" {
+		t.Errorf("FormatCalendarPattern = %q, want K2.3.1", got)
+	}
+	if got := Format(time.Date(2039, 12, 31, 0, 0, 0, 0, time.UTC), RegionJP, "", jc); got != "令和21年12月31日" {
+		t.Errorf("Format before the era = %q, want 令和21年12月31日", got)
+	}
+	if got, err := parseFields("光和元年1月1日", parsePattern("Gy年M月d日"), jc, translate("ja")); err != nil || !got.Equal(time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("parseFields = %v, %v, want 2040-01-01", got, err)
+	}
+	if _, err := jc.ToGregorian(JapaneseEraReiwa, 22, 1, 1); err == nil {
+		t.Error("ToGregorian accepted Reiwa 22 after the new era")
+	}
+}
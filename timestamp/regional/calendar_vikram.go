@@ -0,0 +1,121 @@
+package regional
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/regional/internal/astro"
+)
+
+// VikramSamvatCalendar implements CalendarSystem for the Vikram Samvat, the
+// lunisolar calendar of northern and western India, which is 57 years ahead
+// of the Gregorian one. Its year begins on Chaitra Shukla Pratipada, the day
+// after the new moon before the sun enters Mesha (sidereal Aries), around
+// March and April.
+//
+// Months run from new moon to new moon (amanta) and are named after the
+// solar month that begins during them, with the Saka month names; a month in
+// which the sun enters no new sign is an adhik (leap) month, and IsLeapMonth
+// reports it. The day is the tithi (lunar day) at sunrise: 1 to 15 in the
+// bright fortnight (Shukla paksha) and 16 to 30 in the dark one (Krishna
+// paksha), so a tithi is sometimes skipped or repeated. In the purnimanta
+// reckoning of northern India the dark fortnight belongs to the following
+// month: amanta Phalguna 20 is purnimanta Chaitra Krishna 5.
+//
+// Like drik panchangs, dates are computed from the positions of the sun and
+// moon, at sunrise in Ujjain with the Lahiri ayanamsa; local panchangs may
+// differ by a day when a tithi ends close to sunrise. Dates before 1900 and
+// after 2100 are computed the same way, less precisely, so such differences
+// become more frequent.
+//
+// Example:
+//
+//	t := time.Date(2024, time.April, 9, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionIN, "", VikramSamvatCalendar{}))   // Output: 1 Chaitra 2081 VS
+//	fmt.Println(Format(t, RegionIN, "hi", VikramSamvatCalendar{})) // Output: 1 चैत्र 2081 वि॰ सं॰
+type VikramSamvatCalendar struct{}
+
+// VikramDate is a date of the Vikram Samvat.
+type VikramDate struct {
+	Year  int  // Vikram Samvat year, e.g. 2081 from April 2024
+	Month int  // 1 (Chaitra) to 12 (Phalguna)
+	Leap  bool // The month is an adhik month, e.g. Adhik Shravana in 2023
+	Tithi int  // Lunar day at sunrise, 1 to 30
+}
+
+// Paksha returns the name of the fortnight in lang, "Shukla" (bright) or
+// "Krishna" (dark).
+func (d VikramDate) Paksha(lang string) string {
+	paksha := 0
+	if d.Tithi > 15 {
+		paksha = 1
+	}
+	return translate(lang)(fmt.Sprintf("vikram_paksha_%d", paksha))
+}
+
+// vikramEra is the difference between the Vikram and Gregorian years after
+// Chaitra Shukla Pratipada.
+const vikramEra = 57
+
+// Ujjain, the prime meridian of Indian astronomy.
+const (
+	ujjainLatitude  = 23.1765
+	ujjainLongitude = 75.7885
+)
+
+// Name returns "vikram". CLDR has no Vikram Samvat; the month names are those
+// of the Saka calendar ("indian").
+func (VikramSamvatCalendar) Name() string {
+	return "vikram"
+}
+
+func (VikramSamvatCalendar) monthNameKeys() string {
+	return "indian_"
+}
+
+func (vc VikramSamvatCalendar) Transform(t time.Time) (year int, month int, day int, era string) {
+	d := vc.Date(t)
+	return d.Year, d.Month, d.Tithi, "VS"
+}
+
+// IsLeapMonth reports whether t falls in an adhik month.
+func (vc VikramSamvatCalendar) IsLeapMonth(t time.Time) bool {
+	return vc.Date(t).Leap
+}
+
+// Date converts the calendar date of t to the Vikram Samvat.
+// Algorithm: Dershowitz and Reingold, Calendrical Calculations (modern Hindu
+// lunisolar calendar)
+func (VikramSamvatCalendar) Date(t time.Time) VikramDate {
+	jd := julianDay(t.Year(), int(t.Month()), t.Day())
+	sunrise := astro.Sunrise(indianMidnight(jd), ujjainLatitude, ujjainLongitude)
+
+	lastNewMoon := astro.NewMoonBefore(sunrise)
+	nextNewMoon := astro.NewMoonAtOrAfter(sunrise)
+	sign := rashi(lastNewMoon)
+	month := sign%12 + 1
+
+	year := t.Year() + vikramEra
+	if month >= 7 && t.Month() <= time.June {
+		year-- // Late months falling in January to June belong to the year begun last spring
+	}
+	return VikramDate{
+		Year:  year,
+		Month: month,
+		Leap:  sign == rashi(nextNewMoon),
+		Tithi: int(astro.LunarPhase(sunrise)/12) + 1,
+	}
+}
+
+// rashi returns the sidereal sign of the sun at the moment jd, from 1 (Mesha)
+// to 12 (Meena).
+func rashi(jd float64) int {
+	return int(math.Floor(astro.SiderealSolarLongitude(jd)/30)) + 1
+}
+
+// indianMidnight returns the moment (UT) at which day jd begins in India
+// (UTC+5:30).
+func indianMidnight(jd int) float64 {
+	return float64(jd) - 0.5 - 5.5/24
+}
@@ -21,6 +21,39 @@ const (
 	RegionJP Region = "jp" // YYYY/MM/DD
 	RegionKR Region = "kr" // YYYY.MM.DD
 	RegionCN Region = "cn" // YYYY-MM-DD
+	RegionTW Region = "tw" // YYYY/MM/DD (Taiwan)
+	RegionHK Region = "hk" // DD/MM/YYYY (Hong Kong)
+
+	// South Asia
+	RegionIN Region = "in" // DD/MM/YYYY (India)
+
+	// Europe
+	RegionGB Region = "gb" // DD/MM/YYYY (United Kingdom)
+	RegionDE Region = "de" // DD.MM.YYYY (Germany)
+	RegionFR Region = "fr" // DD/MM/YYYY (France)
+	RegionNL Region = "nl" // DD-MM-YYYY (Netherlands)
+	RegionES Region = "es" // DD/MM/YYYY (Spain)
+	RegionIT Region = "it" // DD/MM/YYYY (Italy)
+	RegionTR Region = "tr" // DD.MM.YYYY (Turkey)
+
+	// Americas
+	RegionBR Region = "br" // DD/MM/YYYY (Brazil)
+	RegionMX Region = "mx" // DD/MM/YYYY (Mexico)
+
+	// Middle East
+	RegionSA Region = "sa" // DD/MM/YYYY (Saudi Arabia)
+	RegionAE Region = "ae" // DD/MM/YYYY (United Arab Emirates)
+	RegionIR Region = "ir" // YYYY/MM/DD Solar Hijri (Iran)
+	RegionAF Region = "af" // YYYY/MM/DD Solar Hijri (Afghanistan)
+	RegionIL Region = "il" // D.M.YYYY (Israel)
+
+	// Africa
+	RegionEG Region = "eg" // DD/MM/YYYY (Egypt)
+	RegionET Region = "et" // DD/MM/YYYY Ethiopian calendar (Ethiopia)
+
+	// Oceania
+	RegionAU Region = "au" // DD/MM/YYYY (Australia)
+	RegionNZ Region = "nz" // DD/MM/YYYY (New Zealand)
 )
 
 const (
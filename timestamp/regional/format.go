@@ -1,88 +1,80 @@
 package regional
 
 import (
-	"fmt"
+	"strings"
 	"time"
-)
 
-var monthsID = []string{
-	"", "Januari", "Februari", "Maret", "April", "Mei", "Juni",
-	"Juli", "Agustus", "September", "Oktober", "November", "Desember",
-}
+	"github.com/Roisfaozi/unik/timestamp/util"
+)
 
-// Format formats a time.Time object based on the specified region and language.
-// It provides different date and time formats for various regions.
+// Format formats a time.Time object in the default pattern of the specified
+// region (RegionSpec.Pattern). The region decides the order of the fields,
+// their separators and the calendar; lang decides the words, so a US layout
+// can show Indonesian month names and an Indonesian one English names. With
+// an empty lang, or one without a registered locale, the names come from the
+// region's own locale.
+//
+// The default pattern is written as is. Regions whose default is a date alone
+// (RegionTH, RegionJP, RegionID) show the time only through FormatStyle and
+// skeletons with an hour field (FormatPattern "jm"), which use their native
+// notation: "15.30 น.", "午後3時30分", "15.30 WIB".
+//
+// When calendar is not nil the date is written in that calendar, with the
+// region's CalendarPattern for lang (RegionIL: "13 בטבת 5784") or, when it has
+// none, its pattern for an era date with the full month name (RegionID with
+// HijriCalendar: "12 Jumadil Akhir 1445 H"). Regions that aren't registered
+// are formatted as RFC 3339.
+//
+// With the pseudo-locale (util.PseudoLocale) the output is bracketed, so text
+// hardcoded in a pattern stands out next to the accented names.
 //
 // Example:
-//   t := time.Date(2023, time.November, 15, 10, 30, 0, 0, time.UTC)
-//   fmt.Println(Format(t, RegionUS, ""))     // Output: "11/15/2023 10:30 AM"
-//   fmt.Println(Format(t, RegionEU, ""))     // Output: "15/11/2023 10:30"
-//   fmt.Println(Format(t, RegionID, LangID)) // Output: "15 November 2023"
-//   fmt.Println(Format(t, RegionTH, ""))     // Output: "15/11/2566" (assuming 2023 + 543 = 2566 BE)
-// Format formats a time.Time object based on the specified region and optional calendar system.
+//
+//	t := time.Date(2023, time.December, 25, 15, 30, 0, 0, time.UTC)
+//	fmt.Println(Format(t, RegionUS, "", nil))     // Output: 12/25/2023 03:30 PM
+//	fmt.Println(Format(t, RegionUS, LangID, nil)) // Output: 12/25/2023 03:30 PM (numeric layout, no words)
+//	fmt.Println(Format(t, RegionID, "", nil))     // Output: 25 Desember 2023
+//	fmt.Println(Format(t, RegionID, LangEN, nil)) // Output: 25 December 2023
+//	fmt.Println(Format(t, RegionTH, "", nil))     // Output: 25/12/2566 (2023 + 543 = 2566 BE)
 func Format(t time.Time, region Region, lang string, calendar CalendarSystem) string {
-	switch region {
-	case RegionUS:
-		return t.Format("01/02/2006 03:04 PM")
-	case RegionEU:
-		return t.Format("02/01/2006 15:04")
-	case RegionCA:
-		return t.Format("2006-01-02")
-	case RegionID:
-		if lang == LangID {
-			return formatID(t)
-		}
-		return formatID(t) 
-	case RegionTH:
-		return formatTH(t)
-	case RegionVN:
-		return t.Format("02/01/2006")
-	case RegionMY, RegionSG:
-		return t.Format("02/01/2006")
-	case RegionPH:
-		return t.Format("01/02/2006")
-	case RegionJP:
-		if calendar != nil {
-			y, m, d, era := calendar.Transform(t)
-			// Format: "Era Year/MM/DD" -> "Reiwa 6/05/01"
-			return fmt.Sprintf("%s %d/%02d/%02d", era, y, m, d)
-		}
-		return t.Format("2006/01/02")
-	case RegionKR:
-		return t.Format("2006.01.02")
-	case RegionCN:
-		return t.Format("2006-01-02")
-	case RegionISO:
-		return t.Format("2006-01-02 15:04:05")
-	default:
-		return t.Format(time.RFC3339)
+	out := format(t, region, lang, calendar)
+	if util.IsPseudoLocale(lang) {
+		return util.PseudoBracket(out)
 	}
+	return out
 }
 
-func formatID(t time.Time) string {
-	d := t.Day()
-	m := t.Month()
-	y := t.Year()
-	
-	mIdx := int(m)
-	if mIdx < 1 || mIdx > 12 {
-		return t.Format("02 Jan 2006")
+func format(t time.Time, region Region, lang string, calendar CalendarSystem) string {
+	spec, ok := LookupRegion(region)
+	if !ok {
+		return t.Format(time.RFC3339)
+	}
+
+	lang = spec.language(lang)
+	pattern, cal := spec.Pattern, spec.Calendar
+	if calendar != nil {
+		pattern, cal = spec.calendarPattern(lang), calendar
 	}
 
-	return fmt.Sprintf("%02d %s %d", d, monthsID[mIdx], y)
+	return formatFields(t, cal, parsePattern(pattern), translate(lang))
 }
 
-// formatTH formats a Thai date string (e.g., "25/10/2023") into a time.Time object.
-// It handles Thai date format by converting it to a standard format before parsing.
-//
-// Example:
-//
-//	timeTH, err := parseTH("25/10/2023")
-//	if err != nil {
-//		// handle error
-//	}
-//	fmt.Println(timeTH) // 2023-10-25 00:00:00 +0000 UTC
-func formatTH(t time.Time) string {
-	be := t.Year() + 543
-	return fmt.Sprintf("%02d/%02d/%d", t.Day(), t.Month(), be)
+// calendarPattern returns the pattern of Format for dates of another calendar
+// in lang: its own CalendarPatterns entry or its parent language's, else the
+// region's CalendarPattern.
+func (spec RegionSpec) calendarPattern(lang string) string {
+	for code := lang; code != ""; {
+		if p, ok := spec.CalendarPatterns[code]; ok {
+			return p
+		}
+		i := strings.LastIndex(code, "-")
+		if i < 0 {
+			break
+		}
+		code = code[:i]
+	}
+	if spec.CalendarPattern != "" {
+		return spec.CalendarPattern
+	}
+	return spec.bestPattern("GyMMMMd")
 }
@@ -18,10 +18,20 @@ func TestFormat(t *testing.T) {
 		{"US Format", RegionUS, LangEN, "12/25/2023 03:30 PM"},
 		{"EU Format", RegionEU, LangEN, "25/12/2023 15:30"},
 		{"ID Format Standard", RegionID, LangID, "25 Desember 2023"},
+		{"ID Format unknown language", RegionID, "zz", "25 Desember 2023"},
+		{"ID Format regional language", RegionID, "en-150", "25 December 2023"},
 		{"TH Format Buddhist Era", RegionTH, LangTH, "25/12/2566"}, 
 		{"JP Format", RegionJP, LangEN, "2023/12/25"},
 		{"CA Format", RegionCA, LangEN, "2023-12-25"},
 		{"ISO Format", RegionISO, LangEN, "2023-12-25 15:30:00"},
+		{"IN Format", RegionIN, LangEN, "25/12/2023"},
+		{"DE Format", RegionDE, LangEN, "25.12.2023"},
+		{"NL Format", RegionNL, LangEN, "25-12-2023"},
+		{"TR Format", RegionTR, LangEN, "25.12.2023"},
+		{"BR Format", RegionBR, LangEN, "25/12/2023"},
+		{"SA Format", RegionSA, LangEN, "25/12/2023"},
+		{"AU Format", RegionAU, LangEN, "25/12/2023"},
+		{"TW Format", RegionTW, LangEN, "2023/12/25"},
 	}
 
 	for _, tt := range tests {
@@ -34,23 +44,111 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+// TestFormat_SmallYears checks that numeric layouts keep four-digit years, as
+// the Go layouts ("2006") before them did.
+func TestFormat_SmallYears(t *testing.T) {
+	tm := time.Date(5, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		region   Region
+		expected string
+	}{
+		{RegionISO, "0005-01-02 03:04:05"},
+		{RegionUS, "01/02/0005 03:04 AM"},
+		{RegionEU, "02/01/0005 03:04"},
+		{RegionCA, "0005-01-02"},
+		{RegionCN, "0005-01-02"},
+		{RegionJP, "0005/01/02"},
+		{RegionKR, "0005.01.02"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tm, tt.region, "", nil); got != tt.expected {
+			t.Errorf("%s: Format() = %q, want %q", tt.region, got, tt.expected)
+		}
+	}
+}
+
 func TestFormat_JPEra(t *testing.T) {
 	// 2024-05-01 is Reiwa 6
 	tm := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
 	// Passing a JapaneseCalendar instance
-	got := Format(tm, RegionJP, LangEN, JapaneseCalendar{})
-	expected := "Reiwa 6/05/01"
+	got := Format(tm, RegionJP, "", JapaneseCalendar{})
+	expected := "令和6年5月1日"
 	if got != expected {
 		t.Errorf("Format JP Era = %v, want %v", got, expected)
 	}
+
+	// Era names follow the language
+	if got := Format(tm, RegionJP, LangEN, JapaneseCalendar{}); got != "Reiwa 6/05/01" {
+		t.Errorf("Format JP Era in English = %v, want Reiwa 6/05/01", got)
+	}
+}
+
+func TestFormat_JPEraStyles(t *testing.T) {
+	reiwa1 := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
+	heisei31 := time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		tm       time.Time
+		pattern  string
+		lang     string
+		calendar JapaneseCalendar
+		expected string
+	}{
+		{"Kanji", reiwa1, "Gy年M月d日", "", JapaneseCalendar{}, "令和1年5月1日"},
+		{"Gannen", reiwa1, "Gy年M月d日", "", JapaneseCalendar{Gannen: true}, "令和元年5月1日"},
+		{"Gannen in English", reiwa1, "G y/MM/dd", LangEN, JapaneseCalendar{Gannen: true}, "Reiwa 1/05/01"},
+		{"Gannen after year 1", heisei31, "Gy年M月d日", "", JapaneseCalendar{Gannen: true}, "平成31年4月30日"},
+		{"Narrow", reiwa1, "GGGGGy.M.d", "", JapaneseCalendar{}, "R1.5.1"},
+		{"Narrow Heisei", heisei31, "GGGGGy'年'", "", JapaneseCalendar{}, "H31年"},
+		{"Narrow in English", heisei31, "GGGGGyy.MM.dd", LangEN, JapaneseCalendar{}, "H31.04.30"},
+		{"Wide", heisei31, "GGGG y", LangEN, JapaneseCalendar{}, "Heisei 31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCalendarPattern(tt.tm, tt.pattern, RegionJP, tt.lang, tt.calendar); got != tt.expected {
+				t.Errorf("FormatCalendarPattern(%q) = %q, want %q", tt.pattern, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormat_JPEraBoundary(t *testing.T) {
+	// Reiwa began on 1 May 2019 in Japan; the era follows the date that is
+	// written, so 15:30 UTC on 30 April is still Heisei 31/4/30
+	tests := []struct {
+		tm       time.Time
+		era      int
+		expected string
+	}{
+		{time.Date(2019, 4, 30, 14, 59, 59, 0, time.UTC), JapaneseEraHeisei, "平成31年4月30日"},
+		{time.Date(2019, 4, 30, 15, 30, 0, 0, time.UTC), JapaneseEraHeisei, "平成31年4月30日"},
+		{time.Date(2019, 4, 30, 15, 30, 0, 0, time.UTC).In(japanTime), JapaneseEraReiwa, "令和1年5月1日"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, japanTime), JapaneseEraReiwa, "令和1年5月1日"},
+		{time.Date(2019, 4, 30, 23, 59, 59, 0, japanTime), JapaneseEraHeisei, "平成31年4月30日"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), JapaneseEraReiwa, "令和1年5月1日"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.FixedZone("PDT", -7*60*60)), JapaneseEraReiwa, "令和1年5月1日"},
+	}
+
+	for _, tt := range tests {
+		if got := (JapaneseCalendar{}).Era(tt.tm); got != tt.era {
+			t.Errorf("Era(%s) = %d, want %d", tt.tm, got, tt.era)
+		}
+		if got := Format(tt.tm, RegionJP, "", JapaneseCalendar{}); got != tt.expected {
+			t.Errorf("Format(%s) = %q, want %q", tt.tm, got, tt.expected)
+		}
+	}
 }
 
 func TestFormat_HijriEra(t *testing.T) {
 	// July 19, 2023 is approx 1 Muharram 1445
 	tm := time.Date(2023, 7, 19, 0, 0, 0, 0, time.UTC)
 	
-	// We use RegionJP just to trigger the calendar logic in Format function for now
-	// In the future, we might have specific formatting for RegionAR or generic
+	// RegionJP has its own calendar pattern ("G y/MM/dd"); see
+	// TestFormat_CalendarEveryRegion for the other regions
 	got := Format(tm, RegionJP, LangEN, HijriCalendar{})
 
 	// Expected: AH 1445/01/01
@@ -71,3 +169,200 @@ func TestFormatID_EdgeCases(t *testing.T) {
 		t.Errorf("ID Dec failed: %v", got)
 	}
 }
+
+func TestFormat_CalendarEveryRegion(t *testing.T) {
+	// 2023-12-25 is 12 Jumada II 1445 in the tabular Hijri calendar
+	tm := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		region   Region
+		lang     string
+		calendar CalendarSystem
+		expected string
+	}{
+		{"ID Hijri", RegionID, "", HijriCalendar{}, "12 Jumadil Akhir 1445 H"},
+		{"MY Hijri", RegionMY, "", HijriCalendar{}, "12 Jamadilakhir 1445 H"},
+		{"MY Hijri in Indonesian", RegionMY, LangID, HijriCalendar{}, "12 Jumadil Akhir 1445 H"},
+		{"SA Hijri", RegionSA, "", HijriCalendar{}, "12 جمادى الآخرة 1445 هـ"},
+		{"SA Umm al-Qura", RegionSA, "", HijriCalendar{Variant: HijriUmmAlQura}, "12 جمادى الآخرة 1445 هـ"},
+		{"ID Hijri a day later", RegionID, "", HijriCalendar{Adjustment: 1}, "13 Jumadil Akhir 1445 H"},
+		{"ID Hijri from the crescent", RegionID, "", HijriCalendar{Variant: HijriAstronomical}, "12 Jumadil Akhir 1445 H"},
+		{"US Hijri", RegionUS, "", HijriCalendar{}, "Jumada II 12, 1445 AH"},
+		{"EU Hijri", RegionEU, "", HijriCalendar{}, "12 Jumada II 1445 AH"},
+		{"ISO Hijri", RegionISO, "", HijriCalendar{}, "AH 1445-06-12"},
+		{"JP Hijri", RegionJP, LangEN, HijriCalendar{}, "AH 1445/06/12"},
+		{"JP Hijri in Japanese", RegionJP, "", HijriCalendar{}, "AH1445年6月12日"},
+		{"TH Hijri replaces Buddhist Era", RegionTH, LangEN, HijriCalendar{}, "12 Jumada II AH 1445"},
+		{"DE Japanese", RegionDE, "", JapaneseCalendar{}, "25. Dezember 5 Reiwa"},
+		{"TH Buddhist", RegionTH, "", BuddhistCalendar{}, "25 ธันวาคม พ.ศ. 2566"},
+		{"US Buddhist", RegionUS, "", BuddhistCalendar{}, "December 25, 2566 BE"},
+		{"TW Minguo", RegionTW, "", MinguoCalendar{}, "民國112年12月25日"},
+		{"DE Minguo", RegionDE, "", MinguoCalendar{}, "25. Dezember 112 Minguo"},
+		{"IR Persian", RegionIR, "", PersianCalendar{}, "۴ دی ۱۴۰۲"},
+		{"AF Persian", RegionAF, "", PersianCalendar{}, "۴ جدی ۱۴۰۲"},
+		{"IR Persian in English", RegionIR, LangEN, PersianCalendar{}, "4 Dey 1402"},
+		{"US Persian", RegionUS, "", PersianCalendar{}, "Dey 4, 1402 AP"},
+		{"IL Hebrew", RegionIL, "", HebrewCalendar{}, "13 בטבת 5784"},
+		{"IL Hebrew in English", RegionIL, LangEN, HebrewCalendar{}, "13 Tevet 5784"},
+		{"US Hebrew", RegionUS, "", HebrewCalendar{}, "Tevet 13, 5784 AM"},
+		{"CN Chinese", RegionCN, "", ChineseCalendar{}, "癸卯2023年11月13日"},
+		{"SG Chinese", RegionSG, "", ChineseCalendar{}, "13 Eleventh Month 2023 gui-mao"},
+		{"SG Chinese in Chinese", RegionSG, "zh", ChineseCalendar{}, "13 冬月 2023 癸卯"},
+		{"US Chinese", RegionUS, "", ChineseCalendar{}, "Eleventh Month 13, 2023 gui-mao"},
+		{"ID Javanese", RegionID, "", JavaneseCalendar{}, "11 Jumadilakir 1957 AJ"},
+		{"US Javanese", RegionUS, "", JavaneseCalendar{}, "Jumadilakir 11, 1957 AJ"},
+		{"IN Saka", RegionIN, "", SakaCalendar{}, "4 Pausa 1945 Saka"},
+		{"IN Saka in Hindi", RegionIN, "hi", SakaCalendar{}, "4 पौष 1945 शक"},
+		{"US Saka", RegionUS, "", SakaCalendar{}, "Pausa 4, 1945 Saka"},
+		{"IN Vikram Samvat", RegionIN, "", VikramSamvatCalendar{}, "14 Agrahayana 2080 VS"},
+		{"IN Vikram Samvat in Hindi", RegionIN, "hi", VikramSamvatCalendar{}, "14 अग्रहायण 2080 वि॰ सं॰"},
+		{"ET Ethiopian", RegionET, "", EthiopianCalendar{}, "15 ታኅሣሥ 2016"},
+		{"ET Ethiopian by default", RegionET, "", nil, "15/04/2016"},
+		{"US Ethiopian", RegionUS, "", EthiopianCalendar{}, "Tahsas 15, 2016 EC"},
+		{"EG Coptic", RegionEG, "", CopticCalendar{}, "15 كيهك 1740 ش"},
+		{"EG Gregorian", RegionEG, "", nil, "25/12/2023"},
+		{"US Coptic", RegionUS, "", CopticCalendar{}, "Kiahk 15, 1740 AM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tm, tt.region, tt.lang, tt.calendar); got != tt.expected {
+				t.Errorf("Format(%s, %q) = %q, want %q", tt.region, tt.lang, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatFields_CalendarNames(t *testing.T) {
+	tm := time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)
+	fields := parsePattern("d MMM y G")
+
+	if got := formatFields(tm, HijriCalendar{}, fields, translate("en")); got != "12 Jum. II 1445 AH" {
+		t.Errorf("formatFields(en) = %q, want %q", got, "12 Jum. II 1445 AH")
+	}
+	// Languages without Hijri names fall back to EN
+	if got := formatFields(tm, HijriCalendar{}, fields, translate("de")); got != "12 Jum. II 1445 AH" {
+		t.Errorf("formatFields(de) = %q, want %q", got, "12 Jum. II 1445 AH")
+	}
+}
+
+func TestFormat_HebrewLeapMonths(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		lang     string
+		expected string
+	}{
+		{time.Date(2024, 2, 24, 0, 0, 0, 0, time.UTC), LangEN, "Adar I 15, 5784 AM"},
+		{time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), LangEN, "Adar II 14, 5784 AM"},
+		{time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), LangEN, "Adar 14, 5785 AM"},
+		{time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), "he", "אדר ב׳ 14, 5784 לבריאת העולם"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.date, RegionUS, tt.lang, HebrewCalendar{}); got != tt.expected {
+			t.Errorf("Format(%s, %q) = %q, want %q", tt.date.Format("2006-01-02"), tt.lang, got, tt.expected)
+		}
+	}
+}
+
+func TestFormat_ChineseLeapMonths(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		region   Region
+		lang     string
+		expected string
+	}{
+		{time.Date(2020, 5, 23, 0, 0, 0, 0, time.UTC), RegionCN, "", "庚子2020年闰4月1日"},
+		{time.Date(2020, 5, 23, 0, 0, 0, 0, time.UTC), RegionTW, "", "庚子2020年閏4月1日"},
+		{time.Date(2020, 5, 23, 0, 0, 0, 0, time.UTC), RegionSG, "zh", "1 闰四月 2020 庚子"},
+		{time.Date(2020, 4, 23, 0, 0, 0, 0, time.UTC), RegionSG, "zh", "1 四月 2020 庚子"},
+		{time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC), RegionUS, "", "Second Monthbis 1, 2023 gui-mao"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.date, tt.region, tt.lang, ChineseCalendar{}); got != tt.expected {
+			t.Errorf("Format(%s, %s, %q) = %q, want %q", tt.date.Format("2006-01-02"), tt.region, tt.lang, got, tt.expected)
+		}
+	}
+}
+
+func TestFormat_VikramAdhikMonths(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		lang     string
+		expected string
+	}{
+		{time.Date(2023, 7, 18, 0, 0, 0, 0, time.UTC), LangEN, "1 Adhik Sravana 2080 VS"},
+		{time.Date(2023, 8, 17, 0, 0, 0, 0, time.UTC), LangEN, "1 Sravana 2080 VS"},
+		{time.Date(2023, 7, 18, 0, 0, 0, 0, time.UTC), "hi", "1 अधिक श्रावण 2080 वि॰ सं॰"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.date, RegionIN, tt.lang, VikramSamvatCalendar{}); got != tt.expected {
+			t.Errorf("Format(%s, %q) = %q, want %q", tt.date.Format("2006-01-02"), tt.lang, got, tt.expected)
+		}
+	}
+}
+
+func TestFormat_MinguoEras(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(1912, 1, 1, 0, 0, 0, 0, time.UTC), "民國1年1月1日"},
+		{time.Date(1911, 12, 31, 0, 0, 0, 0, time.UTC), "民國前1年12月31日"},
+		{time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), "民國前12年3月1日"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.date, RegionTW, "", MinguoCalendar{}); got != tt.expected {
+			t.Errorf("Format(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.expected)
+		}
+	}
+}
+
+func TestFormat_LanguageIndependentOfRegion(t *testing.T) {
+	tm := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		region   Region
+		lang     string
+		expected string
+	}{
+		{"ID layout, region language", RegionID, "", "25 Desember 2023"},
+		{"ID layout in English", RegionID, LangEN, "25 December 2023"},
+		{"ID layout in German", RegionID, "de", "25 Dezember 2023"},
+		{"US layout in Indonesian", RegionUS, LangID, "12/25/2023 03:30 PM"},
+		{"US layout in Thai", RegionUS, LangTH, "12/25/2023 03:30 หลังเที่ยง"},
+		{"TH layout keeps Buddhist Era in English", RegionTH, LangEN, "25/12/2566"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tm, tt.region, tt.lang, nil); got != tt.expected {
+				t.Errorf("Format(%s, %q) = %q, want %q", tt.region, tt.lang, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormat_PseudoLocale(t *testing.T) {
+	tm := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		region   Region
+		expected string
+	}{
+		{RegionUS, "[12/25/2023 03:30 [ÞṀ]]"}, // the day period comes from the locale too
+		{RegionID, "[25 [Ðééçééɱƀééŕ] 2023]"}, // pseudo-English names in the ID layout
+		{RegionTH, "[25/12/2566]"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tm, tt.region, "en-XA", nil); got != tt.expected {
+			t.Errorf("Format(%s, en-XA) = %q, want %q", tt.region, got, tt.expected)
+		}
+	}
+}
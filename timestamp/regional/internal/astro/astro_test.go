@@ -0,0 +1,177 @@
+package astro
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// julian returns the Julian Day of a UTC time.
+func julian(t time.Time) float64 {
+	return float64(t.Unix())/86400 + 2440587.5
+}
+
+// minutes returns the difference of two Julian Days in minutes.
+func minutes(a, b float64) float64 {
+	return math.Abs(a-b) * 24 * 60
+}
+
+func TestSolarLongitudeAfter(t *testing.T) {
+	// Equinoxes and solstices of 2024, UTC, from the US Naval Observatory
+	tests := []struct {
+		lambda   float64
+		expected time.Time
+	}{
+		{0, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)},
+		{90, time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)},
+		{180, time.Date(2024, 9, 22, 12, 44, 0, 0, time.UTC)},
+		{270, time.Date(2024, 12, 21, 9, 21, 0, 0, time.UTC)},
+	}
+
+	start := julian(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	for _, tt := range tests {
+		got := SolarLongitudeAfter(tt.lambda, start)
+		if d := minutes(got, julian(tt.expected)); d > 2 {
+			t.Errorf("SolarLongitudeAfter(%v) is %.1f minutes from %s", tt.lambda, d, tt.expected)
+		}
+		if before := SolarLongitudeBefore(tt.lambda, got+10); minutes(before, got) > 0.1 {
+			t.Errorf("SolarLongitudeBefore(%v) = %v, want %v", tt.lambda, before, got)
+		}
+	}
+}
+
+func TestNewMoon(t *testing.T) {
+	tests := []time.Time{
+		time.Date(2024, 1, 11, 11, 57, 0, 0, time.UTC),
+		time.Date(2024, 2, 9, 22, 59, 0, 0, time.UTC),
+		time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC),
+	}
+
+	for _, expected := range tests {
+		jd := julian(expected)
+		if got := NewMoonAtOrAfter(jd - 3); minutes(got, jd) > 2 {
+			t.Errorf("NewMoonAtOrAfter is %.1f minutes from %s", minutes(got, jd), expected)
+		}
+		if got := NewMoonBefore(jd + 3); minutes(got, jd) > 2 {
+			t.Errorf("NewMoonBefore is %.1f minutes from %s", minutes(got, jd), expected)
+		}
+	}
+}
+
+func TestDeltaT(t *testing.T) {
+	// Values of the Espenak and Meeus table, in seconds
+	tests := []struct {
+		year, expected float64
+	}{
+		{-1000, 25400},
+		{1, 10580},
+		{1000, 1570},
+		{1600, 120},
+		{1700, 9},
+		{1800, 14},
+		{1900, -3},
+		{2000, 64},
+	}
+	for _, tt := range tests {
+		if got := DeltaT(tt.year); math.Abs(got-tt.expected) > 0.02*math.Abs(tt.expected)+1 {
+			t.Errorf("DeltaT(%v) = %.0f, want about %.0f", tt.year, got, tt.expected)
+		}
+	}
+
+	// The polynomials meet at the ends of their ranges
+	for _, year := range []float64{-500, 500, 1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		if d := math.Abs(DeltaT(year-1e-9) - DeltaT(year)); d > 5 {
+			t.Errorf("DeltaT jumps by %.1f s at %v", d, year)
+		}
+	}
+}
+
+func TestNewMoon_AncientDates(t *testing.T) {
+	// A new moon is found, and within a lunation, however far back the date is
+	for _, year := range []int{-1000, 1, 1000} {
+		jd := julian(time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC))
+		if got := NewMoonAtOrAfter(jd); got < jd || got-jd > MeanSynodicMonth {
+			t.Errorf("NewMoonAtOrAfter(%d) is %.1f days after the date", year, got-jd)
+		}
+		if got := NewMoonBefore(jd); got >= jd || jd-got > MeanSynodicMonth {
+			t.Errorf("NewMoonBefore(%d) is %.1f days before the date", year, jd-got)
+		}
+	}
+}
+
+func TestLunarLongitude(t *testing.T) {
+	// Meeus, example 47.a: 1992 April 12, 0h TT
+	jd := 2448724.5 - DeltaT(1992.28)/86400
+	if got := LunarLongitude(jd); math.Abs(got-133.167265) > 0.001 {
+		t.Errorf("LunarLongitude = %.6f, want 133.167265", got)
+	}
+	if got := LunarLatitude(jd); math.Abs(got+3.229126) > 0.001 {
+		t.Errorf("LunarLatitude = %.6f, want -3.229126", got)
+	}
+	if got := LunarDistance(jd); math.Abs(got-368409.7) > 1 {
+		t.Errorf("LunarDistance = %.1f, want 368409.7", got)
+	}
+	// The elongation is zero at new moon
+	if phase := LunarPhase(NewMoon(298)); phase > 0.01 && phase < 359.99 {
+		t.Errorf("LunarPhase at new moon = %.4f", phase)
+	}
+}
+
+func TestSunrise(t *testing.T) {
+	tests := []struct {
+		name                string
+		latitude, longitude float64
+		zone                *time.Location
+		date                time.Time
+		sunrise, sunset     string
+	}{
+		{"New Delhi", 28.6139, 77.2090, time.FixedZone("IST", 19800), time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), "05:23", "19:22"},
+		{"London", 51.5074, -0.1278, time.FixedZone("BST", 3600), time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), "04:43", "21:21"},
+		{"San Francisco", 37.7749, -122.4194, time.FixedZone("PST", -28800), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "07:24", "17:14"},
+	}
+
+	for _, tt := range tests {
+		_, offset := tt.date.In(tt.zone).Zone()
+		midnight := julian(tt.date) - float64(offset)/86400
+		sunrise := Sunrise(midnight, tt.latitude, tt.longitude)
+		want, _ := time.ParseInLocation("2006-01-02 15:04", tt.date.Format("2006-01-02 ")+tt.sunrise, tt.zone)
+		if d := minutes(sunrise, julian(want)); d > 2 {
+			t.Errorf("Sunrise(%s) is %.1f minutes from %s", tt.name, d, tt.sunrise)
+		}
+		sunset := Sunset(midnight, tt.latitude, tt.longitude)
+		want, _ = time.ParseInLocation("2006-01-02 15:04", tt.date.Format("2006-01-02 ")+tt.sunset, tt.zone)
+		if d := minutes(sunset, julian(want)); d > 2 {
+			t.Errorf("Sunset(%s) is %.1f minutes from %s", tt.name, d, tt.sunset)
+		}
+	}
+
+	// No sunrise in the polar night
+	if got := Sunrise(julian(time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)), 78.22, 15.65); !math.IsNaN(got) {
+		t.Errorf("Sunrise(Longyearbyen, December) = %v, want NaN", got)
+	}
+}
+
+func TestLunarAltitude(t *testing.T) {
+	// The crescent at sunset in Jakarta on the 29th of Ramadan 1444, Shaban
+	// 1445 and Ramadan 1445: below the 3° Indonesia requires twice, then above
+	tests := []struct {
+		date                     time.Time
+		minAltitude, maxAltitude float64
+	}{
+		{time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC), 0, 3},
+		{time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), -0.5, 1},
+		{time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC), 3, 8},
+	}
+
+	for _, tt := range tests {
+		sunset := Sunset(julian(tt.date)-7.0/24, -6.2, 106.8)
+		if got := LunarAltitude(sunset, -6.2, 106.8); got < tt.minAltitude || got > tt.maxAltitude {
+			t.Errorf("LunarAltitude(%s) = %.2f, want %v to %v", tt.date.Format("2006-01-02"), got, tt.minAltitude, tt.maxAltitude)
+		}
+	}
+
+	// The moon is opposite the sun at full moon
+	if got := Elongation(NewMoon(298) + MeanSynodicMonth/2); got < 170 {
+		t.Errorf("Elongation at full moon = %.2f", got)
+	}
+}
@@ -0,0 +1,57 @@
+package astro
+
+import "math"
+
+// LunarAltitude returns the altitude of the centre of the moon above the
+// horizon at the moment jd (UT), seen from a place at north latitude and east
+// longitude in degrees. It is topocentric, corrected for the parallax of the
+// moon, without refraction.
+// Algorithm: Meeus, Astronomical Algorithms, chapters 13 and 40
+func LunarAltitude(jd, latitude, longitude float64) float64 {
+	c := (dynamical(jd) - J2000) / 36525
+	ascension, declination := equatorial(LunarLongitude(jd), LunarLatitude(jd), obliquity(c))
+	hourAngle := siderealTime(jd) + longitude - ascension
+	h := asin(sin(latitude)*sin(declination) + cos(latitude)*cos(declination)*cos(hourAngle))
+
+	parallax := asin(earthRadius / LunarDistance(jd))
+	return h - parallax*cos(h)
+}
+
+// LunarSemidiameter returns the apparent radius of the moon seen from the
+// centre of the Earth at the moment jd (UT), in degrees.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 55
+func LunarSemidiameter(jd float64) float64 {
+	return 358473400 / LunarDistance(jd) / 3600
+}
+
+// Elongation returns the geocentric angular distance between the centres of
+// the sun and moon at the moment jd (UT), in degrees.
+func Elongation(jd float64) float64 {
+	cosE := cos(LunarLatitude(jd)) * cos(LunarLongitude(jd)-SolarLongitude(jd))
+	return math.Acos(cosE) * 180 / math.Pi
+}
+
+// earthRadius is the equatorial radius of the Earth in kilometres.
+const earthRadius = 6378.14
+
+// obliquity returns the mean obliquity of the ecliptic, c Julian centuries
+// from J2000, in degrees.
+func obliquity(c float64) float64 {
+	return 23.439291 - 0.0130042*c
+}
+
+// equatorial converts ecliptic longitude and latitude to right ascension and
+// declination, in degrees, for the obliquity eps.
+func equatorial(lambda, beta, eps float64) (ascension, declination float64) {
+	ascension = math.Atan2(sin(lambda)*cos(eps)-math.Tan(beta*math.Pi/180)*sin(eps), cos(lambda)) * 180 / math.Pi
+	declination = asin(sin(beta)*cos(eps) + cos(beta)*sin(eps)*sin(lambda))
+	return mod(ascension, 360), declination
+}
+
+// siderealTime returns the mean sidereal time at Greenwich at the moment jd
+// (UT), in degrees.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 12
+func siderealTime(jd float64) float64 {
+	t := (jd - J2000) / 36525
+	return mod(280.46061837+360.98564736629*(jd-J2000)+0.000387933*t*t-t*t*t/38710000, 360)
+}
@@ -0,0 +1,361 @@
+package astro
+
+import "math"
+
+// NewMoon returns the moment (UT) of the k-th new moon after the one of
+// 6 January 2000; negative k count backwards.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 49
+func NewMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	jde := 2451550.09766 + MeanSynodicMonth*kf + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := 2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t                           // Sun's mean anomaly
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t // Moon's mean anomaly
+	f := 160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t  // Moon's argument of latitude
+	omega := 124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t
+
+	jde += -0.40720*sin(mp) +
+		0.17241*e*sin(m) +
+		0.01608*sin(2*mp) +
+		0.01039*sin(2*f) +
+		0.00739*e*sin(mp-m) -
+		0.00514*e*sin(mp+m) +
+		0.00208*e*e*sin(2*m) -
+		0.00111*sin(mp-2*f) -
+		0.00057*sin(mp+2*f) +
+		0.00056*e*sin(2*mp+m) -
+		0.00042*sin(3*mp) +
+		0.00042*e*sin(m+2*f) +
+		0.00038*e*sin(m-2*f) -
+		0.00024*e*sin(2*mp-m) -
+		0.00017*sin(omega) -
+		0.00007*sin(mp+2*m) +
+		0.00004*sin(2*mp-2*f) +
+		0.00004*sin(3*m) +
+		0.00003*sin(mp+m-2*f) +
+		0.00003*sin(2*mp+2*f) -
+		0.00003*sin(mp+m+2*f) +
+		0.00003*sin(mp-m+2*f) -
+		0.00002*sin(mp-m-2*f) -
+		0.00002*sin(3*mp+m) +
+		0.00002*sin(4*mp)
+
+	// Planetary arguments
+	for _, p := range planetaryTerms {
+		arg := p[1] + p[2]*kf
+		if p[3] != 0 {
+			arg += p[3] * t * t
+		}
+		jde += p[0] * sin(arg)
+	}
+	return universal(jde)
+}
+
+// planetaryTerms are the additional corrections of NewMoon: amplitude in
+// days, then the argument as phase, rate per lunation and T² term.
+var planetaryTerms = [][4]float64{
+	{0.000325, 299.77, 0.107408, -0.009173},
+	{0.000165, 251.88, 0.016321, 0},
+	{0.000164, 251.83, 26.651886, 0},
+	{0.000126, 349.42, 36.412478, 0},
+	{0.000110, 84.66, 18.206239, 0},
+	{0.000062, 141.74, 53.303771, 0},
+	{0.000060, 207.14, 2.453732, 0},
+	{0.000056, 154.84, 7.306860, 0},
+	{0.000047, 34.52, 27.261239, 0},
+	{0.000042, 207.19, 0.121824, 0},
+	{0.000040, 291.34, 1.844379, 0},
+	{0.000037, 161.72, 24.198154, 0},
+	{0.000035, 239.56, 25.513099, 0},
+	{0.000023, 331.55, 3.592518, 0},
+}
+
+// searchSteps bounds the lunations NewMoonAtOrAfter and NewMoonBefore step
+// from the mean new moon; the true one is less than a day away from it.
+const searchSteps = 4
+
+// NewMoonAtOrAfter returns the first new moon at or after jd (UT).
+func NewMoonAtOrAfter(jd float64) float64 {
+	k := int(math.Floor((jd - 2451550.09766) / MeanSynodicMonth))
+	for i := 0; i < searchSteps && NewMoon(k) < jd; i++ {
+		k++
+	}
+	for i := 0; i < searchSteps && NewMoon(k-1) >= jd; i++ {
+		k--
+	}
+	return NewMoon(k)
+}
+
+// NewMoonBefore returns the last new moon before jd (UT).
+func NewMoonBefore(jd float64) float64 {
+	k := int(math.Ceil((jd - 2451550.09766) / MeanSynodicMonth))
+	for i := 0; i < searchSteps && NewMoon(k) >= jd; i++ {
+		k--
+	}
+	for i := 0; i < searchSteps && NewMoon(k+1) < jd; i++ {
+		k++
+	}
+	return NewMoon(k)
+}
+
+// lunarTerms are the periodic terms of the moon's longitude: the multiples of
+// D, M, M' and F and the amplitude in millionths of a degree.
+// Source: Meeus, Astronomical Algorithms, table 47.A
+var lunarTerms = [][5]float64{
+	{0, 0, 1, 0, 6288774},
+	{2, 0, -1, 0, 1274027},
+	{2, 0, 0, 0, 658314},
+	{0, 0, 2, 0, 213618},
+	{0, 1, 0, 0, -185116},
+	{0, 0, 0, 2, -114332},
+	{2, 0, -2, 0, 58793},
+	{2, -1, -1, 0, 57066},
+	{2, 0, 1, 0, 53322},
+	{2, -1, 0, 0, 45758},
+	{0, 1, -1, 0, -40923},
+	{1, 0, 0, 0, -34720},
+	{0, 1, 1, 0, -30383},
+	{2, 0, 0, -2, 15327},
+	{0, 0, 1, 2, -12528},
+	{0, 0, 1, -2, 10980},
+	{4, 0, -1, 0, 10675},
+	{0, 0, 3, 0, 10034},
+	{4, 0, -2, 0, 8548},
+	{2, 1, -1, 0, -7888},
+	{2, 1, 0, 0, -6766},
+	{1, 0, -1, 0, -5163},
+	{1, 1, 0, 0, 4987},
+	{2, -1, 1, 0, 4036},
+	{2, 0, 2, 0, 3994},
+	{4, 0, 0, 0, 3861},
+	{2, 0, -3, 0, 3665},
+	{0, 1, -2, 0, -2689},
+	{2, 0, -1, 2, -2602},
+	{2, -1, -2, 0, 2390},
+	{1, 0, 1, 0, -2348},
+	{2, -2, 0, 0, 2236},
+	{0, 1, 2, 0, -2120},
+	{0, 2, 0, 0, -2069},
+	{2, -2, -1, 0, 2048},
+	{2, 0, 1, -2, -1773},
+	{2, 0, 0, 2, -1595},
+	{4, -1, -1, 0, 1215},
+	{0, 0, 2, 2, -1110},
+	{3, 0, -1, 0, -892},
+	{2, 1, 1, 0, -810},
+	{4, -1, -2, 0, 759},
+	{0, 2, -1, 0, -713},
+	{2, 2, -1, 0, -700},
+	{2, 1, -2, 0, 691},
+	{2, -1, 0, -2, 596},
+	{4, 0, 1, 0, 549},
+	{0, 0, 4, 0, 537},
+	{4, -1, 0, 0, 520},
+	{1, 0, -2, 0, -487},
+	{2, 1, 0, -2, -399},
+	{0, 0, 2, -2, -381},
+	{1, 1, 1, 0, 351},
+	{3, 0, -2, 0, -340},
+	{4, 0, -3, 0, 330},
+	{2, -1, 2, 0, 327},
+	{0, 2, 1, 0, -323},
+	{1, 1, -1, 0, 299},
+	{2, 0, 3, 0, 294},
+}
+
+// LunarLongitude returns the apparent geocentric longitude of the moon at the
+// moment jd (UT), in degrees, to about 10 arc seconds.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 47
+func LunarLongitude(jd float64) float64 {
+	c, a := lunarArguments(jd)
+	sum := a.series(lunarTerms, sin)
+	a1 := 119.75 + 131.849*c // Venus
+	a2 := 53.09 + 479264.290*c
+	sum += 3958*sin(a1) + 1962*sin(a.lp-a.f) + 318*sin(a2)
+
+	return mod(a.lp+sum/1e6+nutation(c), 360)
+}
+
+// LunarLatitude returns the geocentric latitude of the moon at the moment jd
+// (UT), in degrees north of the ecliptic.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 47
+func LunarLatitude(jd float64) float64 {
+	c, a := lunarArguments(jd)
+	sum := a.series(lunarLatitudeTerms, sin)
+	a1 := 119.75 + 131.849*c
+	a3 := 313.45 + 481266.484*c
+	sum += -2235*sin(a.lp) + 382*sin(a3) + 175*sin(a1-a.f) + 175*sin(a1+a.f) + 127*sin(a.lp-a.mp) - 115*sin(a.lp+a.mp)
+	return sum / 1e6
+}
+
+// LunarDistance returns the distance from the centre of the Earth to the
+// centre of the moon at the moment jd (UT), in kilometres, to about 10 km.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 47
+func LunarDistance(jd float64) float64 {
+	_, a := lunarArguments(jd)
+	return 385000.56 + a.series(lunarDistanceTerms, cos)/1000
+}
+
+// lunarArgs are the fundamental arguments of the moon's series, in degrees,
+// and the eccentricity factor of the Earth's orbit.
+type lunarArgs struct {
+	lp, d, m, mp, f, e float64
+}
+
+// lunarArguments returns the Julian centuries from J2000 (TT) of the moment
+// jd (UT) and the arguments of the series at that moment.
+func lunarArguments(jd float64) (float64, lunarArgs) {
+	c := (dynamical(jd) - J2000) / 36525
+	return c, lunarArgs{
+		lp: 218.3164477 + 481267.88123421*c - 0.0015786*c*c + c*c*c/538841 - c*c*c*c/65194000, // Mean longitude
+		d:  297.8501921 + 445267.1114034*c - 0.0018819*c*c + c*c*c/545868 - c*c*c*c/113065000, // Mean elongation
+		m:  357.5291092 + 35999.0502909*c - 0.0001536*c*c + c*c*c/24490000,                    // Sun's mean anomaly
+		mp: 134.9633964 + 477198.8675055*c + 0.0087414*c*c + c*c*c/69699 - c*c*c*c/14712000,   // Moon's mean anomaly
+		f:  93.2720950 + 483202.0175233*c - 0.0036539*c*c - c*c*c/3526000 + c*c*c*c/863310000, // Argument of latitude
+		e:  1 - 0.002516*c - 0.0000074*c*c,
+	}
+}
+
+// series sums periodic terms of the sine (longitude, latitude) or cosine
+// (distance) of their arguments, correcting those of the sun's mean anomaly
+// for the eccentricity of the Earth's orbit.
+func (a lunarArgs) series(terms [][5]float64, fn func(deg float64) float64) float64 {
+	sum := 0.0
+	for _, term := range terms {
+		amp := term[4]
+		switch math.Abs(term[1]) {
+		case 1:
+			amp *= a.e
+		case 2:
+			amp *= a.e * a.e
+		}
+		sum += amp * fn(term[0]*a.d+term[1]*a.m+term[2]*a.mp+term[3]*a.f)
+	}
+	return sum
+}
+
+// lunarLatitudeTerms are the periodic terms of the moon's latitude, in
+// millionths of a degree.
+// Source: Meeus, Astronomical Algorithms, table 47.B
+var lunarLatitudeTerms = [][5]float64{
+	{0, 0, 0, 1, 5128122},
+	{0, 0, 1, 1, 280602},
+	{0, 0, 1, -1, 277693},
+	{2, 0, 0, -1, 173237},
+	{2, 0, -1, 1, 55413},
+	{2, 0, -1, -1, 46271},
+	{2, 0, 0, 1, 32573},
+	{0, 0, 2, 1, 17198},
+	{2, 0, 1, -1, 9266},
+	{0, 0, 2, -1, 8822},
+	{2, -1, 0, -1, 8216},
+	{2, 0, -2, -1, 4324},
+	{2, 0, 1, 1, 4200},
+	{2, 1, 0, -1, -3359},
+	{2, -1, -1, 1, 2463},
+	{2, -1, 0, 1, 2211},
+	{2, -1, -1, -1, 2065},
+	{0, 1, -1, -1, -1870},
+	{4, 0, -1, -1, 1828},
+	{0, 1, 0, 1, -1794},
+	{0, 0, 0, 3, -1749},
+	{0, 1, -1, 1, -1565},
+	{1, 0, 0, 1, -1491},
+	{0, 1, 1, 1, -1475},
+	{0, 1, 1, -1, -1410},
+	{0, 1, 0, -1, -1344},
+	{1, 0, 0, -1, -1335},
+	{0, 0, 3, 1, 1107},
+	{4, 0, 0, -1, 1021},
+	{4, 0, -1, 1, 833},
+	{0, 0, 1, -3, 777},
+	{4, 0, -2, 1, 671},
+	{2, 0, 0, -3, 607},
+	{2, 0, 2, -1, 596},
+	{2, -1, 1, -1, 491},
+	{2, 0, -2, 1, -451},
+	{0, 0, 3, -1, 439},
+	{2, 0, 2, 1, 422},
+	{2, 0, -3, -1, 421},
+	{2, 1, -1, 1, -366},
+	{2, 1, 0, 1, -351},
+	{4, 0, 0, 1, 331},
+	{2, -1, 1, 1, 315},
+	{2, -2, 0, -1, 302},
+	{0, 0, 1, 3, -283},
+	{2, 1, 1, -1, -229},
+	{1, 1, 0, -1, 223},
+	{1, 1, 0, 1, 223},
+	{0, 1, -2, -1, -220},
+	{2, 1, -1, -1, -220},
+	{1, 0, 1, 1, -185},
+	{2, -1, -2, -1, 181},
+	{0, 1, 2, 1, -177},
+	{4, 0, -2, -1, 176},
+	{4, -1, -1, -1, 166},
+	{1, 0, 1, -1, -164},
+	{4, 0, 1, -1, 132},
+	{1, 0, -1, -1, -119},
+	{4, -1, 0, -1, 115},
+	{2, -2, 0, 1, 107},
+}
+
+// lunarDistanceTerms are the periodic terms of the moon's distance, in
+// metres.
+// Source: Meeus, Astronomical Algorithms, table 47.A
+var lunarDistanceTerms = [][5]float64{
+	{0, 0, 1, 0, -20905355},
+	{2, 0, -1, 0, -3699111},
+	{2, 0, 0, 0, -2955968},
+	{0, 0, 2, 0, -569925},
+	{0, 1, 0, 0, 48888},
+	{0, 0, 0, 2, -3149},
+	{2, 0, -2, 0, 246158},
+	{2, -1, -1, 0, -152138},
+	{2, 0, 1, 0, -170733},
+	{2, -1, 0, 0, -204586},
+	{0, 1, -1, 0, -129620},
+	{1, 0, 0, 0, 108743},
+	{0, 1, 1, 0, 104755},
+	{2, 0, 0, -2, 10321},
+	{0, 0, 1, -2, 79661},
+	{4, 0, -1, 0, -34782},
+	{0, 0, 3, 0, -23210},
+	{4, 0, -2, 0, -21636},
+	{2, 1, -1, 0, 24208},
+	{2, 1, 0, 0, 30824},
+	{1, 0, -1, 0, -8379},
+	{1, 1, 0, 0, -16675},
+	{2, -1, 1, 0, -12831},
+	{2, 0, 2, 0, -10445},
+	{4, 0, 0, 0, -11650},
+	{2, 0, -3, 0, 14403},
+	{0, 1, -2, 0, -7003},
+	{2, -1, -2, 0, 10056},
+	{1, 0, 1, 0, 6322},
+	{2, -2, 0, 0, -9884},
+	{0, 1, 2, 0, 5751},
+	{2, -2, -1, 0, -4950},
+	{2, 0, 1, -2, 4130},
+	{4, -1, -1, 0, -3958},
+	{3, 0, -1, 0, 3258},
+	{2, 1, 1, 0, 2616},
+	{4, -1, -2, 0, -1897},
+	{0, 2, -1, 0, -2117},
+	{2, 2, -1, 0, 2354},
+	{4, 0, 1, 0, -1423},
+	{0, 0, 4, 0, -1117},
+	{4, -1, 0, 0, -1571},
+	{1, 0, -2, 0, -1739},
+	{0, 0, 2, -2, -4421},
+	{0, 2, 1, 0, 1165},
+	{2, 0, -1, -2, 8752},
+}
+
+// LunarPhase returns the elongation of the moon from the sun at the moment
+// jd (UT) in degrees: 0 at new moon, 180 at full moon.
+func LunarPhase(jd float64) float64 {
+	return mod(LunarLongitude(jd)-SolarLongitude(jd), 360)
+}
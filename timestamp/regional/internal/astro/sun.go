@@ -0,0 +1,170 @@
+package astro
+
+import "math"
+
+// solarTerms are the coefficients of the periodic terms of the sun's
+// longitude: amplitude, phase and rate per Julian century.
+// Source: Dershowitz and Reingold, Calendrical Calculations (after Bretagnon
+// and Simon, Planetary Programs and Tables)
+var solarTerms = [][3]float64{
+	{403406, 270.54861, 0.9287892},
+	{195207, 340.19128, 35999.1376958},
+	{119433, 63.91854, 35999.4089666},
+	{112392, 331.26220, 35998.7287385},
+	{3891, 317.843, 71998.20261},
+	{2819, 86.631, 71998.4403},
+	{1721, 240.052, 36000.35726},
+	{660, 310.26, 71997.4812},
+	{350, 247.23, 32964.4678},
+	{334, 260.87, -19.4410},
+	{314, 297.82, 445267.1117},
+	{268, 343.14, 45036.8840},
+	{242, 166.79, 3.1008},
+	{234, 81.53, 22518.4434},
+	{158, 3.50, -19.9739},
+	{132, 132.75, 65928.9345},
+	{129, 182.95, 9038.0293},
+	{114, 162.03, 3034.7684},
+	{99, 29.8, 33718.148},
+	{93, 266.4, 3034.448},
+	{86, 249.2, -2280.773},
+	{78, 157.6, 29929.992},
+	{72, 257.8, 31556.493},
+	{68, 185.1, 149.588},
+	{64, 69.9, 9037.750},
+	{46, 8.0, 107997.405},
+	{38, 197.1, -4444.176},
+	{37, 250.4, 151.771},
+	{32, 65.3, 67555.316},
+	{29, 162.7, 31556.080},
+	{28, 341.5, -4561.540},
+	{27, 291.6, 107996.706},
+	{27, 98.5, 1221.655},
+	{25, 146.7, 62894.167},
+	{24, 110.0, 31437.369},
+	{21, 5.2, 14578.298},
+	{21, 342.6, -31931.757},
+	{20, 230.9, 34777.243},
+	{18, 256.1, 1221.999},
+	{17, 45.3, 62894.511},
+	{14, 242.9, -4442.039},
+	{13, 115.2, 107997.909},
+	{13, 151.8, 119.066},
+	{13, 285.3, 16859.071},
+	{12, 53.3, -4.578},
+	{10, 126.6, 26895.292},
+	{10, 205.7, -39.127},
+	{10, 85.9, 12297.536},
+	{10, 146.1, 90073.778},
+}
+
+// SolarLongitude returns the apparent geocentric longitude of the sun at the
+// moment jd (UT), in degrees from the March equinox.
+func SolarLongitude(jd float64) float64 {
+	c := (dynamical(jd) - J2000) / 36525
+	sum := 0.0
+	for _, term := range solarTerms {
+		sum += term[0] * sin(term[1]+term[2]*c)
+	}
+	lambda := 282.7771834 + 36000.76953744*c + 0.000005729577951308232*sum
+	return mod(lambda+aberration(c)+nutation(c), 360)
+}
+
+// aberration is the displacement of the sun by the Earth's orbital motion.
+func aberration(c float64) float64 {
+	return 0.0000974*cos(177.63+35999.01848*c) - 0.005575
+}
+
+// nutation is the nutation in longitude, to 0.001 degree.
+func nutation(c float64) float64 {
+	a := 124.90 - 1934.134*c + 0.002063*c*c
+	b := 201.11 + 72001.5377*c + 0.00057*c*c
+	return -0.004778*sin(a) - 0.0003667*sin(b)
+}
+
+// SolarLongitudeAfter returns the first moment (UT) after jd at which the sun
+// reaches longitude lambda, such as 270 for the December solstice.
+func SolarLongitudeAfter(lambda, jd float64) float64 {
+	rate := MeanTropicalYear / 360
+	tau := jd + rate*mod(lambda-SolarLongitude(jd), 360)
+	lo, hi := math.Max(jd, tau-5), tau+5
+	return bisect(lambda, lo, hi)
+}
+
+// SolarLongitudeBefore returns the last moment (UT) before jd at which the
+// sun reached longitude lambda.
+func SolarLongitudeBefore(lambda, jd float64) float64 {
+	rate := MeanTropicalYear / 360
+	tau := jd - rate*mod(SolarLongitude(jd)-lambda, 360)
+	lo, hi := tau-5, math.Min(jd, tau+5)
+	return bisect(lambda, lo, hi)
+}
+
+// bisect finds the moment in [lo, hi] at which the sun reaches lambda, to
+// within a second.
+func bisect(lambda, lo, hi float64) float64 {
+	for hi-lo > 1e-5 {
+		mid := (lo + hi) / 2
+		if mod(SolarLongitude(mid)-lambda, 360) < 180 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// Ayanamsa returns the Lahiri (Chitrapaksha) ayanamsa at the moment jd, the
+// distance in degrees from the March equinox to the start of the sidereal
+// zodiac used by the Indian calendars.
+func Ayanamsa(jd float64) float64 {
+	c := (jd - J2000) / 36525
+	return 23.85305 + 1.396971*c + 0.000308*c*c
+}
+
+// SiderealSolarLongitude returns the longitude of the sun at the moment jd
+// (UT) measured from the start of the sidereal zodiac (Mesha), in degrees.
+func SiderealSolarLongitude(jd float64) float64 {
+	return mod(SolarLongitude(jd)-Ayanamsa(jd), 360)
+}
+
+// Sunrise returns the moment (UT) of sunrise on the day that begins at the
+// moment midnight (UT) at a place, north latitude and east longitude in
+// degrees: the upper limb of the sun on the horizon, with standard
+// refraction. It returns NaN when the sun does not rise that day.
+// Algorithm: Meeus, Astronomical Algorithms, chapter 15 (iterated)
+func Sunrise(midnight, latitude, longitude float64) float64 {
+	return sunOnHorizon(midnight, latitude, longitude, -1)
+}
+
+// Sunset returns the moment (UT) of sunset on the day that begins at the
+// moment midnight (UT), as Sunrise does for sunrise. It returns NaN when the
+// sun does not set that day.
+func Sunset(midnight, latitude, longitude float64) float64 {
+	return sunOnHorizon(midnight, latitude, longitude, 1)
+}
+
+// sunOnHorizon returns sunrise (side -1) or sunset (side 1).
+func sunOnHorizon(midnight, latitude, longitude, side float64) float64 {
+	t := midnight + 0.5 + side/4
+	for i := 0; i < 3; i++ {
+		c := (dynamical(t) - J2000) / 36525
+		lambda := SolarLongitude(t)
+		ascension, declination := equatorial(lambda, 0, obliquity(c))
+
+		// Equation of time: the mean sun's longitude minus the right ascension
+		mean := 280.46646 + 36000.76983*c
+		eot := mod(mean-0.0057183-ascension+nutation(c)*cos(obliquity(c))+180, 360) - 180
+
+		cosH := (sin(-0.8333) - sin(latitude)*sin(declination)) / (cos(latitude) * cos(declination))
+		if cosH < -1 || cosH > 1 {
+			return math.NaN()
+		}
+		h := math.Acos(cosH) * 180 / math.Pi
+		// Apparent noon of the day: 12:00 UT moved by the longitude and the
+		// equation of time
+		noon := math.Floor(midnight+1) - (longitude+eot)/360
+		t = noon + side*h/360
+	}
+	return t
+}
@@ -0,0 +1,40 @@
+package regional
+
+import (
+	"math"
+	"time"
+)
+
+// julianDay returns the Julian Day Number of a Gregorian date, the day count
+// the conversions of the other calendars go through.
+// Algorithm: Fliegel and Van Flandern (1968)
+func julianDay(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// fromJulianDay is the inverse of julianDay.
+func fromJulianDay(jd int) (year, month, day int) {
+	l := jd + 68569
+	n := 4 * l / 146097
+	l -= (146097*n + 3) / 4
+	i := 4000 * (l + 1) / 1461001
+	l -= 1461*i/4 - 31
+	j := 80 * l / 2447
+	day = l - 2447*j/80
+	l = j / 11
+	month = j + 2 - 12*l
+	year = 100*(n-49) + i + l
+	return year, month, day
+}
+
+// julianTime converts a moment given as a Julian Day to a time in UTC,
+// rounded to the second.
+func julianTime(jd float64) time.Time {
+	day := math.Floor(jd + 0.5)
+	y, m, d := fromJulianDay(int(day))
+	seconds := math.Round((jd + 0.5 - day) * 86400)
+	return time.Date(y, time.Month(m), d, 0, 0, int(seconds), 0, time.UTC)
+}
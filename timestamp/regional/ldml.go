@@ -0,0 +1,756 @@
+package regional
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Roisfaozi/unik/timestamp/smart"
+	"github.com/Roisfaozi/unik/timestamp/util"
+)
+
+// FormatPattern formats t with an LDML (Unicode TR35) date pattern or skeleton.
+//
+// A pattern mixes field letters with literal text, which may be quoted:
+// "EEEE, d MMMM y 'at' HH:mm". A string made of field letters only is a
+// skeleton: it lists the wanted fields without order or punctuation and is
+// resolved to the region's preferred pattern with BestPattern.
+//
+// Month, weekday, day-period and era names come from the smart locale of lang,
+// or of the region when lang is empty or has no registered locale.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 15, 30, 0, 0, time.UTC)
+//	fmt.Println(FormatPattern(t, "yMMMd", RegionUS, ""))          // Output: Dec 25, 2023
+//	fmt.Println(FormatPattern(t, "yMMMd", RegionEU, ""))          // Output: 25 Dec 2023
+//	fmt.Println(FormatPattern(t, "yMMMMEEEEd", RegionID, ""))     // Output: Senin, 25 Desember 2023
+//	fmt.Println(FormatPattern(t, "EEEE d MMMM y, HH:mm", RegionUS, LangID)) // Output: Senin 25 Desember 2023, 15:30
+func FormatPattern(t time.Time, pattern string, region Region, lang string) string {
+	return FormatCalendarPattern(t, pattern, region, lang, nil)
+}
+
+// FormatCalendarPattern is FormatPattern with the year, month, day and era
+// fields in calendar, or in the region's calendar when it is nil. The width
+// of the era field picks the name: "G" to "GGGG" the abbreviated one and
+// "GGGGG" the narrow one, which gives the short forms of Japanese dates.
+//
+// Example:
+//
+//	t := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+//	fmt.Println(FormatCalendarPattern(t, "GGGGGy.M.d", RegionJP, "", JapaneseCalendar{})) // Output: R6.5.1
+//	fmt.Println(FormatCalendarPattern(t, "Gy年M月d日", RegionJP, "", JapaneseCalendar{}))  // Output: 令和6年5月1日
+func FormatCalendarPattern(t time.Time, pattern string, region Region, lang string, calendar CalendarSystem) string {
+	spec := specFor(region, lang)
+	lang = spec.language(lang)
+	if isSkeleton(pattern) {
+		pattern = spec.bestPattern(pattern)
+	}
+	if calendar == nil {
+		calendar = spec.Calendar
+	}
+	return render(t, calendar, pattern, lang)
+}
+
+// render formats t with a resolved pattern, bracketing pseudo-locale output.
+// The year, month, day and era fields follow cal when it is not nil.
+func render(t time.Time, cal CalendarSystem, pattern, lang string) string {
+	out := formatFields(t, cal, parsePattern(pattern), translate(lang))
+	if util.IsPseudoLocale(lang) {
+		return util.PseudoBracket(out)
+	}
+	return out
+}
+
+// translate returns the name lookup of lang's smart locale.
+func translate(lang string) func(key string) string {
+	return func(key string) string { return smart.GetTrans(lang, key) }
+}
+
+// BestPattern resolves an LDML skeleton such as "yMMMd" or "Hm" to the
+// region's pattern for those fields, following the CLDR matching rules in
+// simplified form: the available skeleton with the same fields and the closest
+// widths wins, and its fields are then widened or narrowed to the request
+// ("yMMMMd" reuses "yMMMd" with full month names). Skeletons mixing date and
+// time fields are resolved per half and joined with the locale's date-time
+// pattern. The "j" hour letter stands for the region's preferred hour cycle.
+// lang is only used when the region has no locale of its own.
+//
+// Example:
+//
+//	fmt.Println(BestPattern("yMd", RegionUS, ""))     // Output: M/d/y
+//	fmt.Println(BestPattern("yMMMMd", RegionEU, ""))  // Output: d MMMM y
+//	fmt.Println(BestPattern("yMdjm", RegionCA, ""))   // Output: y-MM-dd, h:mm a
+func BestPattern(skeleton string, region Region, lang string) string {
+	return specFor(region, lang).bestPattern(skeleton)
+}
+
+func (spec RegionSpec) bestPattern(skeleton string) string {
+	if strings.ContainsRune(skeleton, 'j') {
+		skeleton = strings.ReplaceAll(skeleton, "j", string(spec.preferredHour()))
+	}
+
+	req := parseSkeleton(skeleton)
+	candidates := spec.skeletonCandidates()
+	if p, ok := matchSkeleton(req, candidates); ok {
+		return p
+	}
+
+	// Split into date and time halves, as CLDR does for "yMMMdHm"
+	date, clock := req.split()
+	if len(date.fields) == 0 || len(clock.fields) == 0 {
+		return req.fallback()
+	}
+	datePattern, ok := matchSkeleton(date, candidates)
+	if !ok {
+		datePattern = date.fallback()
+	}
+	timePattern, ok := matchSkeleton(clock, candidates)
+	if !ok {
+		timePattern = clock.fallback()
+	}
+	return spec.joinDateTime(datePattern, timePattern)
+}
+
+// joinDateTime combines a date and a time pattern with the region's
+// date-time pattern, e.g. "{1}, {0}".
+func (spec RegionSpec) joinDateTime(date, clock string) string {
+	glue := spec.pattern("pattern_datetime")
+	return strings.NewReplacer("{1}", date, "{0}", clock).Replace(glue)
+}
+
+// pattern looks up a "pattern_" key, falling back to the region's locale.
+func (spec RegionSpec) pattern(key string) string {
+	if p, ok := spec.Patterns[key]; ok {
+		return p
+	}
+	return smart.GetTrans(spec.Locale, key)
+}
+
+// language returns lang when it or a parent language has a registered
+// locale, and the region's locale otherwise, so both "" and an unknown code
+// such as "zz" get the region's own words rather than English.
+func (spec RegionSpec) language(lang string) string {
+	for code := lang; code != ""; {
+		if _, ok := smart.LookupLocale(code); ok {
+			return lang
+		}
+		i := strings.LastIndex(code, "-")
+		if i < 0 {
+			break
+		}
+		code = code[:i]
+	}
+	return spec.Locale
+}
+
+// preferredHour returns the hour letter of the region's hour cycle, or of its
+// short time pattern when the cycle isn't set.
+func (spec RegionSpec) preferredHour() byte {
+	if spec.HourCycle != "" {
+		return spec.HourCycle.letter()
+	}
+	for _, f := range parsePattern(spec.pattern("pattern_time_short")) {
+		switch f.letter {
+		case 'h', 'K':
+			return 'h'
+		case 'H', 'k':
+			return 'H'
+		}
+	}
+	return 'H'
+}
+
+// skeletonCandidates collects the available skeleton patterns of the region's
+// locale, its parent languages and EN, with region overrides taking precedence.
+func (spec RegionSpec) skeletonCandidates() map[string]string {
+	const prefix = "pattern_skeleton_"
+	candidates := map[string]string{}
+	add := func(dict map[string]string) {
+		for key, p := range dict {
+			if sk, ok := strings.CutPrefix(key, prefix); ok {
+				if _, seen := candidates[sk]; !seen {
+					candidates[sk] = p
+				}
+			}
+		}
+	}
+
+	add(spec.Patterns)
+	for code := spec.Locale; code != ""; {
+		if l, ok := smart.LookupLocale(code); ok {
+			add(l.Dictionary)
+		}
+		i := strings.LastIndex(code, "-")
+		if i < 0 {
+			break
+		}
+		code = code[:i]
+	}
+	if l, ok := smart.LookupLocale("en"); ok {
+		add(l.Dictionary)
+	}
+	return candidates
+}
+
+// isSkeleton reports whether s consists of pattern letters only.
+func isSkeleton(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isPatternLetter(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isPatternLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// supportedLetters are the pattern letters formatField and parseFields handle.
+const supportedLetters = "GyMLdDEceahHKkmsSzOZXx"
+
+// validatePattern reports the first pattern letter that isn't supported.
+func validatePattern(pattern string) error {
+	for _, f := range parsePattern(pattern) {
+		if f.letter != 0 && !strings.ContainsRune(supportedLetters, rune(f.letter)) {
+			return fmt.Errorf("pattern %q: unsupported field %q", pattern, strings.Repeat(string(f.letter), f.width))
+		}
+	}
+	return nil
+}
+
+// patternField is a run of one pattern letter, or a literal when letter is 0.
+type patternField struct {
+	letter  byte
+	width   int
+	literal string
+}
+
+// parsePattern splits an LDML pattern into fields and literals.
+// Text between single quotes is literal; a doubled quote, inside or outside
+// quoted text, stands for an apostrophe.
+func parsePattern(pattern string) []patternField {
+	var fields []patternField
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			fields = append(fields, patternField{literal: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				lit.WriteByte('\'')
+				i += 2
+				continue
+			}
+			for i++; i < len(pattern); i++ {
+				if pattern[i] != '\'' {
+					lit.WriteByte(pattern[i])
+				} else if i+1 < len(pattern) && pattern[i+1] == '\'' {
+					lit.WriteByte('\'')
+					i++
+				} else {
+					break
+				}
+			}
+			i++ // closing quote
+		case isPatternLetter(c):
+			j := i
+			for j < len(pattern) && pattern[j] == c {
+				j++
+			}
+			flush()
+			fields = append(fields, patternField{letter: c, width: j - i})
+			i = j
+		default:
+			lit.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return fields
+}
+
+// fieldType groups letters that display the same field ("L" and "M" are both months).
+func fieldType(c byte) byte {
+	switch c {
+	case 'L':
+		return 'M'
+	case 'c', 'e':
+		return 'E'
+	case 'H', 'K', 'k':
+		return 'h'
+	case 'Z', 'O', 'v', 'V', 'X', 'x':
+		return 'z'
+	}
+	return c
+}
+
+// isTextWidth reports whether a field of this letter and width is rendered as
+// text (month and weekday names) rather than digits.
+func isTextWidth(c byte, width int) bool {
+	switch fieldType(c) {
+	case 'M':
+		return width >= 3
+	case 'E', 'G', 'a', 'z':
+		return true
+	}
+	return false
+}
+
+// skeleton is a parsed skeleton: one entry per field type, in request order.
+type skeleton struct {
+	fields []patternField
+}
+
+func parseSkeleton(s string) skeleton {
+	var sk skeleton
+	for _, f := range parsePattern(s) {
+		// The day period is implied by a 12-hour field
+		if f.letter == 0 || f.letter == 'a' {
+			continue
+		}
+		if _, ok := sk.field(fieldType(f.letter)); ok {
+			continue
+		}
+		sk.fields = append(sk.fields, f)
+	}
+	return sk
+}
+
+func (sk skeleton) field(typ byte) (patternField, bool) {
+	for _, f := range sk.fields {
+		if fieldType(f.letter) == typ {
+			return f, true
+		}
+	}
+	return patternField{}, false
+}
+
+func (sk skeleton) split() (date, clock skeleton) {
+	for _, f := range sk.fields {
+		switch fieldType(f.letter) {
+		case 'h', 'm', 's', 'S', 'z':
+			clock.fields = append(clock.fields, f)
+		default:
+			date.fields = append(date.fields, f)
+		}
+	}
+	return date, clock
+}
+
+// fallback renders the requested fields as a pattern when no locale pattern
+// has them: date fields separated by spaces, time fields by colons.
+func (sk skeleton) fallback() string {
+	date, clock := sk.split()
+	var parts []string
+	for _, f := range date.fields {
+		parts = append(parts, strings.Repeat(string(f.letter), f.width))
+	}
+	var tparts []string
+	for _, f := range clock.fields {
+		tparts = append(tparts, strings.Repeat(string(f.letter), f.width))
+	}
+	if len(tparts) > 0 {
+		t := strings.Join(tparts, ":")
+		if f, ok := clock.field('h'); ok && (f.letter == 'h' || f.letter == 'K') {
+			t += " a"
+		}
+		parts = append(parts, t)
+	}
+	return strings.Join(parts, " ")
+}
+
+// matchSkeleton picks the candidate with the same field types as req and the
+// smallest width distance, then adjusts its pattern to the requested widths.
+func matchSkeleton(req skeleton, candidates map[string]string) (string, bool) {
+	keys := make([]string, 0, len(candidates))
+	for key := range candidates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // deterministic choice between equal distances
+
+	best, bestDist := "", -1
+	for _, key := range keys {
+		cand := parseSkeleton(key)
+		if len(cand.fields) != len(req.fields) {
+			continue
+		}
+		dist, ok := 0, true
+		for _, rf := range req.fields {
+			cf, found := cand.field(fieldType(rf.letter))
+			if !found {
+				ok = false
+				break
+			}
+			dist += fieldDistance(rf, cf)
+		}
+		if ok && (bestDist < 0 || dist < bestDist) {
+			best, bestDist = key, dist
+		}
+	}
+	if bestDist < 0 {
+		return "", false
+	}
+	return adjustWidths(candidates[best], req, parseSkeleton(best)), true
+}
+
+func fieldDistance(req, cand patternField) int {
+	dist := 0
+	if isTextWidth(req.letter, req.width) != isTextWidth(cand.letter, cand.width) {
+		dist += 100
+	}
+	switch {
+	case req.letter == cand.letter:
+	case is12Hour(req.letter) == is12Hour(cand.letter) && fieldType(req.letter) == 'h':
+		dist++ // same clock, other numbering: "K" for "h"
+	default:
+		dist += 10 // e.g. a 24-hour pattern for a 12-hour request
+	}
+	if req.width > cand.width {
+		dist += req.width - cand.width
+	} else {
+		dist += cand.width - req.width
+	}
+	return dist
+}
+
+func is12Hour(c byte) bool {
+	return c == 'h' || c == 'K'
+}
+
+// adjustWidths rewrites the date fields of pattern whose requested width
+// differs from the matched skeleton, without switching between digits and text.
+func adjustWidths(pattern string, req, matched skeleton) string {
+	fields := parsePattern(pattern)
+	var b strings.Builder
+	for _, f := range fields {
+		if f.letter == 0 {
+			b.WriteString(quoteLiteral(f.literal))
+			continue
+		}
+		width := f.width
+		typ := fieldType(f.letter)
+		rf, rok := req.field(typ)
+		mf, mok := matched.field(typ)
+		if rok && mok && rf.width != mf.width && typ != 'h' && typ != 'm' && typ != 's' &&
+			isTextWidth(rf.letter, rf.width) == isTextWidth(f.letter, f.width) {
+			width = rf.width
+		}
+		b.WriteString(strings.Repeat(string(f.letter), width))
+	}
+	return b.String()
+}
+
+// quoteLiteral quotes literal text that contains pattern letters or apostrophes.
+func quoteLiteral(s string) string {
+	for i := 0; i < len(s); i++ {
+		if isPatternLetter(s[i]) || s[i] == '\'' {
+			return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		}
+	}
+	return s
+}
+
+// dateFields holds the calendar date shown by the y, M, d and G fields.
+type dateFields struct {
+	year, month, day int
+	era              string // Era name from a CalendarSystem; "" uses the locale's era names
+	names            string // Key prefix of a NamedCalendar's names, e.g. "islamic_"
+	eraIndex         int    // CLDR index of the era of an EraCalendar
+	months           string // Key prefix of the month names; "" for the Gregorian ones
+	leapYear         bool   // The year of a LeapYearCalendar is a leap year
+	leapMonth        bool   // The month of a LeapMonthCalendar is a leap month
+	yearName         string // Key of the name of the year of a yearNameCalendar, e.g. "gannen"
+}
+
+func calendarFields(t time.Time, cal CalendarSystem) dateFields {
+	if cal == nil {
+		return dateFields{year: t.Year(), month: int(t.Month()), day: t.Day()}
+	}
+	y, m, d, era := cal.Transform(t)
+	date := dateFields{year: y, month: m, day: d, era: era}
+	if named, ok := cal.(NamedCalendar); ok {
+		date.names = named.Name() + "_"
+	}
+	if eras, ok := cal.(EraCalendar); ok {
+		date.eraIndex = eras.Era(t)
+	}
+	date.months = monthNames(cal)
+	if leap, ok := cal.(LeapYearCalendar); ok {
+		date.leapYear = leap.IsLeapYear(y)
+	}
+	if leap, ok := cal.(LeapMonthCalendar); ok {
+		date.leapMonth = leap.IsLeapMonth(t)
+	}
+	if named, ok := cal.(yearNameCalendar); ok {
+		date.yearName = named.yearName(y)
+	}
+	return date
+}
+
+// monthNames returns the key prefix of the month names of cal, "" when it
+// uses the Gregorian months.
+func monthNames(cal CalendarSystem) string {
+	if b, ok := cal.(borrowedMonthCalendar); ok {
+		return b.monthNameKeys()
+	}
+	if named, ok := cal.(NamedCalendar); ok {
+		return named.Name() + "_"
+	}
+	return ""
+}
+
+// name looks up a name of the date's calendar, falling back to fallback
+// when the locale has none.
+func (date dateFields) name(tr func(key string) string, key, fallback string) string {
+	if date.names == "" {
+		return fallback
+	}
+	return lookup(tr, date.names+key, fallback)
+}
+
+// lookup returns the name under key, or fallback when the locale has none.
+func lookup(tr func(key string) string, key, fallback string) string {
+	if val := tr(key); val != key {
+		return val
+	}
+	return fallback
+}
+
+// formatFields renders parsed pattern fields for t, taking names and digits
+// from tr.
+func formatFields(t time.Time, cal CalendarSystem, fields []patternField, tr func(key string) string) string {
+	date := calendarFields(t, cal)
+	digits := nativeDigits(tr)
+	var b strings.Builder
+	for _, f := range fields {
+		if f.letter == 0 {
+			b.WriteString(f.literal)
+			continue
+		}
+		b.WriteString(replaceDigits(formatField(t, date, f, tr), "0123456789", digits))
+	}
+	return b.String()
+}
+
+// nativeDigits returns the digits 0-9 of the locale's default numbering
+// system ("۰۱۲۳۴۵۶۷۸۹" in FA), or "" for ASCII digits.
+func nativeDigits(tr func(key string) string) string {
+	if digits := tr("digits"); utf8.RuneCountInString(digits) == 10 {
+		return digits
+	}
+	return ""
+}
+
+// replaceDigits replaces each digit of from in s with the digit of to at the
+// same position. It returns s unchanged when to is "".
+func replaceDigits(s, from, to string) string {
+	if to == "" {
+		return s
+	}
+	src, dst := []rune(from), []rune(to)
+	return strings.Map(func(r rune) rune {
+		for i, d := range src {
+			if r == d {
+				return dst[i]
+			}
+		}
+		return r
+	}, s)
+}
+
+func formatField(t time.Time, date dateFields, f patternField, tr func(key string) string) string {
+	n := f.width
+	switch f.letter {
+	case 'G':
+		if date.era != "" {
+			name := date.name(tr, fmt.Sprintf("era_%d", date.eraIndex), date.era)
+			if n == 5 {
+				return date.name(tr, fmt.Sprintf("era_narrow_%d", date.eraIndex), name)
+			}
+			return name
+		}
+		if date.year > 0 {
+			return tr("era_1")
+		}
+		return tr("era_0")
+	case 'y':
+		if date.yearName != "" {
+			if name := date.name(tr, date.yearName, ""); name != "" {
+				return name
+			}
+		}
+		y := date.year
+		if y <= 0 && date.era == "" {
+			y = 1 - y // year of era: 1 BC is year 0
+		}
+		if n == 2 {
+			return fmt.Sprintf("%02d", y%100)
+		}
+		return pad(y, n)
+	case 'M', 'L':
+		name := date.monthField(f, tr)
+		if date.leapMonth {
+			name = strings.Replace(date.name(tr, "month_pattern_leap", "{0}"), "{0}", name, 1)
+		}
+		return name
+	case 'd':
+		return pad(date.day, n)
+	case 'D':
+		return pad(t.YearDay(), n)
+	case 'E', 'c', 'e':
+		wd := int(t.Weekday())
+		if (f.letter == 'c' || f.letter == 'e') && n <= 2 {
+			return pad((wd+6)%7+1, n) // numeric day of week, Monday = 1
+		}
+		switch n {
+		case 4:
+			return tr(fmt.Sprintf("weekday_%d", wd))
+		case 5:
+			return firstRune(tr(fmt.Sprintf("weekday_%d", wd)))
+		default:
+			return tr(fmt.Sprintf("weekday_short_%d", wd))
+		}
+	case 'a':
+		if t.Hour() < 12 {
+			return tr("am")
+		}
+		return tr("pm")
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return pad(h, n)
+	case 'H':
+		return pad(t.Hour(), n)
+	case 'K':
+		return pad(t.Hour()%12, n)
+	case 'k':
+		h := t.Hour()
+		if h == 0 {
+			h = 24
+		}
+		return pad(h, n)
+	case 'm':
+		return pad(t.Minute(), n)
+	case 's':
+		return pad(t.Second(), n)
+	case 'S':
+		frac := fmt.Sprintf("%09d", t.Nanosecond())
+		for len(frac) < n {
+			frac += "0"
+		}
+		return frac[:n]
+	case 'z':
+		if name, _ := t.Zone(); n < 4 && name != "" && isPatternLetter(name[0]) {
+			return name
+		}
+		return gmtOffset(t, n >= 4)
+	case 'O':
+		return gmtOffset(t, n >= 4)
+	case 'Z':
+		switch {
+		case n == 4:
+			return gmtOffset(t, true)
+		case n == 5:
+			return isoOffset(t, true, true)
+		default:
+			return isoOffset(t, false, false)
+		}
+	case 'X', 'x':
+		return isoOffset(t, n >= 3, f.letter == 'X')
+	}
+	return strings.Repeat(string(f.letter), n) // unsupported letters are kept as-is
+}
+
+// monthField formats the M and L fields, without the leap-month pattern.
+func (date dateFields) monthField(f patternField, tr func(key string) string) string {
+	n := f.width
+	m := date.month
+	switch {
+	case n <= 2:
+		return pad(m, n)
+	case date.months != "":
+		// Other calendars only have format names, wide and abbreviated
+		prefix := "month_"
+		if n == 3 {
+			prefix = "month_short_"
+		}
+		name := lookup(tr, fmt.Sprintf("%s%s%d", date.months, prefix, m), pad(m, 2))
+		if date.leapYear {
+			name = lookup(tr, fmt.Sprintf("%s%sleap_%d", date.months, prefix, m), name)
+		}
+		if n == 5 {
+			return firstRune(name)
+		}
+		return name
+	case n == 3:
+		return tr(fmt.Sprintf("month_short_%d", m))
+	case n == 4 && f.letter == 'L':
+		return tr(fmt.Sprintf("month_standalone_%d", m))
+	case n == 4:
+		return tr(fmt.Sprintf("month_%d", m))
+	default:
+		return firstRune(tr(fmt.Sprintf("month_standalone_%d", m)))
+	}
+}
+
+func pad(v, width int) string {
+	return fmt.Sprintf("%0*d", width, v)
+}
+
+func firstRune(s string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size]
+}
+
+// gmtOffset renders the localized GMT format: "GMT+7" or, long, "GMT+07:00".
+func gmtOffset(t time.Time, long bool) string {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return "GMT"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	h, m := offset/3600, offset%3600/60
+	switch {
+	case long:
+		return fmt.Sprintf("GMT%c%02d:%02d", sign, h, m)
+	case m != 0:
+		return fmt.Sprintf("GMT%c%d:%02d", sign, h, m)
+	default:
+		return fmt.Sprintf("GMT%c%d", sign, h)
+	}
+}
+
+// isoOffset renders an ISO 8601 offset: "+0700" or, with colon, "+07:00".
+// With zulu set a zero offset is written as "Z".
+func isoOffset(t time.Time, colon, zulu bool) string {
+	_, offset := t.Zone()
+	if offset == 0 && zulu {
+		return "Z"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	if colon {
+		return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset%3600/60)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
@@ -0,0 +1,82 @@
+package regional
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatPattern(t *testing.T) {
+	// Fixed date: 2023-12-25 15:30:05 UTC (a Monday)
+	fixedTime := time.Date(2023, 12, 25, 15, 30, 5, 0, time.UTC)
+	jakarta := time.FixedZone("WIB", 7*3600)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		region   Region
+		lang     string
+		expected string
+	}{
+		// Skeletons follow the region
+		{"Skeleton US", "yMMMd", RegionUS, "", "Dec 25, 2023"},
+		{"Skeleton EU", "yMMMd", RegionEU, "", "25 Dec 2023"},
+		{"Skeleton EU numeric", "yMd", RegionEU, "", "25/12/2023"},
+		{"Skeleton CA numeric", "yMd", RegionCA, "", "2023-12-25"},
+		{"Skeleton ISO with time", "yMdHm", RegionISO, "", "2023-12-25 15:30"},
+		{"Skeleton widened month", "yMMMMEEEEd", RegionID, "", "Senin, 25 Desember 2023"},
+		{"Skeleton JP", "yMMMEd", RegionJP, "", "2023年12月25日(月)"},
+		{"Skeleton preferred hour US", "jm", RegionUS, "", "3:30 PM"},
+		{"Skeleton preferred hour EU", "jm", RegionEU, "", "15:30"},
+		{"Skeleton single field", "MMMM", RegionUS, "", "December"},
+		{"Skeleton layout with other language", "yMMMMd", RegionUS, LangID, "Desember 25, 2023"},
+
+		// Patterns are used as written
+		{"Pattern", "EEEE d MMMM y, HH:mm:ss", RegionUS, LangID, "Senin 25 Desember 2023, 15:30:05"},
+		{"Pattern 12-hour", "hh:mm a", RegionUS, "", "03:30 PM"},
+		{"Pattern quoted literal", "d 'de' MMMM 'de' y", RegionUS, "es", "25 de diciembre de 2023"},
+		{"Pattern apostrophe", "h 'o''clock' a", RegionUS, "", "3 o'clock PM"},
+		{"Pattern two-digit year and era", "dd.MM.yy G", RegionUS, "", "25.12.23 AD"},
+		{"Pattern fraction and offset", "HH:mm:ss.SSS XXX", RegionUS, "", "15:30:05.000 Z"},
+		{"Pseudo-locale", "d MMMM", RegionUS, "en-XA", "[25 [Ðééçééɱƀééŕ]]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatPattern(fixedTime, tt.pattern, tt.region, tt.lang)
+			if got != tt.expected {
+				t.Errorf("FormatPattern(%q) = %q, want %q", tt.pattern, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("Time zones", func(t *testing.T) {
+		tm := fixedTime.In(jakarta)
+		if got := FormatPattern(tm, "HH:mm z ZZZZ Z", RegionID, ""); got != "22:30 WIB GMT+07:00 +0700" {
+			t.Errorf("FormatPattern(zones) = %q, want %q", got, "22:30 WIB GMT+07:00 +0700")
+		}
+	})
+}
+
+func TestBestPattern(t *testing.T) {
+	tests := []struct {
+		skeleton string
+		region   Region
+		expected string
+	}{
+		{"yMd", RegionUS, "M/d/y"},
+		{"yMd", RegionEU, "dd/MM/y"},
+		{"yMMMMd", RegionEU, "d MMMM y"},
+		{"yMMMMd", RegionUS, "MMMM d, y"},
+		{"yMdjm", RegionCA, "y-MM-dd, h:mm a"},
+		{"Hms", RegionUS, "HH:mm:ss"},
+		{"hm", RegionEU, "h:mm a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.skeleton+"/"+string(tt.region), func(t *testing.T) {
+			if got := BestPattern(tt.skeleton, tt.region, ""); got != tt.expected {
+				t.Errorf("BestPattern(%q, %s) = %q, want %q", tt.skeleton, tt.region, got, tt.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package regional
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -9,6 +10,11 @@ import (
 // Parse parses a date string according to the specified region's format.
 // It returns a time.Time object and an error if parsing fails.
 //
+// The string must follow the region's default pattern (RegionSpec.Pattern),
+// the same one Format writes. Month and day-period names are matched without
+// regard to case, and years of a region calendar such as the Buddhist Era of
+// RegionTH are converted back to the Gregorian calendar.
+//
 // Example:
 //
 //	timeUS, err := Parse("10/25/2023 03:30 PM", RegionUS)
@@ -17,107 +23,372 @@ import (
 //	}
 //	fmt.Println(timeUS) // 2023-10-25 15:30:00 +0000 UTC
 //
-//	timeEU, err := Parse("25/10/2023 15:30", RegionEU)
+//	timeID, err := Parse("25 Oktober 2023", RegionID)
 //	if err != nil {
 //		// handle error
 //	}
-//	fmt.Println(timeEU) // 2023-10-25 15:30:00 +0000 UTC
+//	fmt.Println(timeID) // 2023-10-25 00:00:00 +0000 UTC
 //
-//	timeCA, err := Parse("2023-10-25", RegionCA)
+//	timeTH, err := Parse("25/10/2566", RegionTH)
 //	if err != nil {
 //		// handle error
 //	}
-//	fmt.Println(timeCA) // 2023-10-25 00:00:00 +0000 UTC
+//	fmt.Println(timeTH) // 2023-10-25 00:00:00 +0000 UTC
 func Parse(dateStr string, region Region) (time.Time, error) {
-	var layout string
-
-	switch region {
-	case RegionUS:
-		layout = "01/02/2006 03:04 PM"
-	case RegionEU:
-		layout = "02/01/2006 15:04"
-	case RegionCA:
-		layout = "2006-01-02"
-	case RegionID:
-
-		return parseID(dateStr)
-	case RegionTH:
-		return parseTH(dateStr)
-	case RegionVN, RegionMY, RegionSG:
-		layout = "02/01/2006"
-	case RegionPH:
-		layout = "01/02/2006"
-	case RegionJP:
-		layout = "2006/01/02"
-	case RegionKR:
-		layout = "2006.01.02"
-	case RegionCN:
-		layout = "2006-01-02"
-	case RegionISO:
-		layout = "2006-01-02 15:04:05"
-	default:
+	spec, ok := LookupRegion(region)
+	if !ok {
 		return time.Time{}, fmt.Errorf("unsupported region for parsing: %s", region)
 	}
+	return parseFields(dateStr, parsePattern(spec.Pattern), spec.Calendar, translate(spec.Locale))
+}
 
-	return time.Parse(layout, dateStr)
+// multiEraCalendar is implemented by calendars with eras beyond era 1, such
+// as the Japanese one. eras returns the CLDR indices of the eras that can be
+// parsed.
+type multiEraCalendar interface {
+	eras() []int
 }
 
-// parseID parses an Indonesian date string (e.g., "01 Januari 2006") into a time.Time object.
-// It handles Indonesian month names by converting them to English before parsing.
-//
-// Example:
-//
-//	timeID, err := parseID("25 April 2023")
-//	if err != nil {
-//		// handle error
-//	}
-//	fmt.Println(timeID) // 2023-04-25 00:00:00 +0000 UTC
-func parseID(dateStr string) (time.Time, error) {
-	replacer := strings.NewReplacer(
-		"Januari", "January",
-		"Februari", "February",
-		"Maret", "March",
-		"April", "April",
-		"Mei", "May",
-		"Juni", "June",
-		"Juli", "July",
-		"Agustus", "August",
-		"September", "September",
-		"Oktober", "October",
-		"November", "November",
-		"Desember", "December",
-	)
-	normalized := replacer.Replace(dateStr)
-	return time.Parse("02 January 2006", normalized)
+// fieldParser holds the values read so far by parseFields.
+type fieldParser struct {
+	value string
+	tr    func(key string) string
+	cal   CalendarSystem
+
+	year, month, day, yearDay  int
+	hour, minute, second, nsec int
+	hourLetter                 byte
+	eraIndex                   int  // CLDR index of the era of a NamedCalendar
+	pm, bc, hasEra             bool // bc: Gregorian era 0
+	loc                        *time.Location
 }
 
-// parseTH parses a Thai date string (e.g., "25/10/2023") into a time.Time object.
-// It handles Thai date format by converting it to a standard format before parsing.
-//
-// Example:
-//
-//	timeTH, err := parseTH("25/10/2023")
-//	if err != nil {
-//		// handle error
-//	}
-//	fmt.Println(timeTH) // 2023-10-25 00:00:00 +0000 UTC
-func parseTH(dateStr string) (time.Time, error) {
-	
-    parts := strings.Split(dateStr, "/")
-    if len(parts) != 3 {
-        return time.Time{}, fmt.Errorf("invalid format for TH")
-    }
-    
-    day := parts[0]
-    month := parts[1]
-    yearBE := parts[2]
-    
-    var yBE int
-    if _, err := fmt.Sscanf(yearBE, "%d", &yBE); err != nil {
-        return time.Time{}, fmt.Errorf("invalid year BE: %w", err)
-    }
-    yAD := yBE - 543
-    
-    isoStr := fmt.Sprintf("%04d-%s-%s", yAD, month, day)
-    return time.Parse("2006-01-02", isoStr)
+// parseFields is the inverse of formatFields. Numeric fields directly followed
+// by another numeric field ("yyyyMMdd") read exactly their width in digits;
+// otherwise they read every digit available, in ASCII or the locale's native
+// digits. Like time.Parse, an unknown zone abbreviation yields a zone of that
+// name at UTC.
+func parseFields(value string, fields []patternField, cal CalendarSystem, tr func(key string) string) (time.Time, error) {
+	ascii := value
+	if digits := nativeDigits(tr); digits != "" {
+		ascii = replaceDigits(value, digits, "0123456789")
+	}
+	p := &fieldParser{value: ascii, tr: tr, cal: cal, month: 1, day: 1, loc: time.UTC}
+
+	for i, f := range fields {
+		if f.letter == 0 {
+			if !strings.HasPrefix(p.value, f.literal) {
+				return time.Time{}, fmt.Errorf("parsing %q: expected %q at %q", value, f.literal, p.value)
+			}
+			p.value = p.value[len(f.literal):]
+			continue
+		}
+		adjacent := i+1 < len(fields) && fields[i+1].letter != 0 && !isTextWidth(fields[i+1].letter, fields[i+1].width)
+		if err := p.field(f, adjacent); err != nil {
+			return time.Time{}, fmt.Errorf("parsing %q: %w", value, err)
+		}
+	}
+	if p.value != "" {
+		return time.Time{}, fmt.Errorf("parsing %q: unexpected text %q", value, p.value)
+	}
+	return p.time(value)
+}
+
+func (p *fieldParser) field(f patternField, adjacent bool) error {
+	if isTextWidth(f.letter, f.width) {
+		return p.text(f)
+	}
+
+	if f.letter == 'y' && p.yearName() {
+		return nil
+	}
+
+	limit := 0
+	if adjacent {
+		limit = f.width
+	}
+	digits := p.digits(limit)
+	if digits == "" {
+		return fmt.Errorf("expected digits for %q at %q", strings.Repeat(string(f.letter), f.width), p.value)
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return err
+	}
+
+	switch f.letter {
+	case 'y':
+		p.year = n
+		if f.width == 2 && len(digits) == 2 {
+			// Same pivot as time.Parse: 69-99 are 1900s, 00-68 are 2000s
+			p.year += 1900
+			if n < 69 {
+				p.year += 100
+			}
+		}
+	case 'M', 'L':
+		p.month = n
+	case 'd':
+		p.day = n
+	case 'D':
+		p.yearDay = n
+	case 'h', 'H', 'K', 'k':
+		p.hour, p.hourLetter = n, f.letter
+	case 'm':
+		p.minute = n
+	case 's':
+		p.second = n
+	case 'S':
+		for len(digits) < 9 {
+			digits += "0"
+		}
+		p.nsec, _ = strconv.Atoi(digits[:9])
+	}
+	return nil // c and e: the weekday follows from the date
+}
+
+// yearName reads the name of the first year of an era of a yearNameCalendar,
+// such as 元 of 令和元年, and reports whether it was there.
+func (p *fieldParser) yearName() bool {
+	named, ok := p.cal.(NamedCalendar)
+	years, ok2 := p.cal.(yearNameCalendar)
+	if !ok || !ok2 || years.yearName(1) == "" {
+		return false
+	}
+	if _, err := p.match([]string{named.Name() + "_" + years.yearName(1)}, "year"); err != nil {
+		return false
+	}
+	p.year = 1
+	return true
+}
+
+// digits consumes up to limit digits, or all of them when limit is 0.
+func (p *fieldParser) digits(limit int) string {
+	n := 0
+	for n < len(p.value) && p.value[n] >= '0' && p.value[n] <= '9' && (limit == 0 || n < limit) {
+		n++
+	}
+	digits := p.value[:n]
+	p.value = p.value[n:]
+	return digits
+}
+
+func (p *fieldParser) text(f patternField) error {
+	switch fieldType(f.letter) {
+	case 'M':
+		return p.monthName()
+	case 'E':
+		var keys []string
+		for wd := 0; wd < 7; wd++ {
+			keys = append(keys, fmt.Sprintf("weekday_%d", wd), fmt.Sprintf("weekday_short_%d", wd))
+		}
+		_, err := p.match(keys, "weekday")
+		return err
+	case 'a':
+		i, err := p.match([]string{"am", "pm"}, "day period")
+		p.pm = i == 1
+		return err
+	case 'G':
+		return p.era()
+	}
+	return p.zone(f)
+}
+
+// monthName reads a month name of the calendar. Calendars with names of their
+// own only have the format names, wide and abbreviated, and may have a 13th
+// month and leap-year names.
+func (p *fieldParser) monthName() error {
+	formats, months := []string{"month_%d", "month_short_%d", "month_standalone_%d"}, 12
+	if prefix := monthNames(p.cal); prefix != "" {
+		formats = []string{prefix + "month_%d", prefix + "month_short_%d", prefix + "month_leap_%d", prefix + "month_short_leap_%d"}
+		months = 13
+	}
+
+	var keys []string
+	for m := 1; m <= months; m++ {
+		for _, format := range formats {
+			keys = append(keys, fmt.Sprintf(format, m))
+		}
+	}
+	i, err := p.match(keys, "month")
+	p.month = i/len(formats) + 1
+	return err
+}
+
+// era reads the era of the calendar. In the Gregorian calendar, era 0 counts
+// years backwards (BC); other calendars interpret their eras in ToGregorian.
+func (p *fieldParser) era() error {
+	named, ok := p.cal.(NamedCalendar)
+	if !ok {
+		i, err := p.match([]string{"era_0", "era_1"}, "era")
+		p.bc = i == 0
+		return err
+	}
+	eras := []int{0, 1}
+	if multi, ok := named.(multiEraCalendar); ok {
+		eras = multi.eras()
+	}
+	keys := make([]string, len(eras))
+	for i, era := range eras {
+		keys[i] = fmt.Sprintf("%s_era_%d", named.Name(), era)
+	}
+	i, err := p.match(keys, "era")
+	if err != nil {
+		return err
+	}
+	p.eraIndex, p.hasEra = eras[i], true
+	return nil
+}
+
+// match consumes the longest name among keys, ignoring case, and returns its index.
+func (p *fieldParser) match(keys []string, what string) (int, error) {
+	best, bestLen := -1, 0
+	for i, key := range keys {
+		name := p.tr(key)
+		if name == "" || name == key || len(name) <= bestLen || len(name) > len(p.value) {
+			continue
+		}
+		if strings.EqualFold(p.value[:len(name)], name) {
+			best, bestLen = i, len(name)
+		}
+	}
+	if best < 0 {
+		return 0, fmt.Errorf("unknown %s name at %q", what, p.value)
+	}
+	p.value = p.value[bestLen:]
+	return best, nil
+}
+
+// zone reads the z, O, Z, X and x fields.
+func (p *fieldParser) zone(f patternField) error {
+	if (f.letter == 'X' || f.letter == 'Z' && f.width == 5) && strings.HasPrefix(p.value, "Z") {
+		p.value = p.value[1:]
+		p.loc = time.UTC
+		return nil
+	}
+	if f.letter == 'X' || f.letter == 'x' || f.letter == 'Z' && f.width != 4 {
+		offset, err := p.offset()
+		if err != nil {
+			return err
+		}
+		p.loc = time.FixedZone("", offset)
+		return nil
+	}
+
+	if strings.HasPrefix(p.value, "GMT") || strings.HasPrefix(p.value, "UTC") {
+		p.value = p.value[3:]
+		if p.value == "" || p.value[0] != '+' && p.value[0] != '-' {
+			p.loc = time.UTC
+			return nil
+		}
+		offset, err := p.offset()
+		if err != nil {
+			return err
+		}
+		p.loc = time.FixedZone("", offset)
+		return nil
+	}
+
+	n := 0
+	for n < len(p.value) && isPatternLetter(p.value[n]) {
+		n++
+	}
+	if f.letter != 'z' || n < 3 {
+		return fmt.Errorf("expected time zone at %q", p.value)
+	}
+	p.loc = time.FixedZone(p.value[:n], 0)
+	p.value = p.value[n:]
+	return nil
+}
+
+// offset reads "+07", "+0700", "+07:00" or, after GMT, "+7" and "+7:30".
+func (p *fieldParser) offset() (int, error) {
+	if p.value == "" || p.value[0] != '+' && p.value[0] != '-' {
+		return 0, fmt.Errorf("expected zone offset at %q", p.value)
+	}
+	sign := 1
+	if p.value[0] == '-' {
+		sign = -1
+	}
+	p.value = p.value[1:]
+
+	hours := p.digits(2)
+	if len(hours) == 0 {
+		return 0, fmt.Errorf("expected zone offset at %q", p.value)
+	}
+	h, _ := strconv.Atoi(hours)
+	if strings.HasPrefix(p.value, ":") {
+		p.value = p.value[1:]
+	}
+	m := 0
+	if minutes := p.digits(2); minutes != "" {
+		m, _ = strconv.Atoi(minutes)
+	}
+	if h > 23 || m > 59 {
+		return 0, fmt.Errorf("zone offset out of range")
+	}
+	return sign * (h*3600 + m*60), nil
+}
+
+// time assembles and validates the parsed fields.
+func (p *fieldParser) time(value string) (time.Time, error) {
+	year, month, day := p.year, p.month, p.day
+	if p.cal != nil {
+		bi, ok := p.cal.(BidirectionalCalendar)
+		if !ok {
+			return time.Time{}, fmt.Errorf("parsing %q: dates of this calendar cannot be parsed", value)
+		}
+		era := p.eraIndex
+		if eras, ok := p.cal.(EraCalendar); ok && !p.hasEra {
+			era = eras.Era(time.Now()) // Without a G field, dates are in the current era
+		}
+		t, err := bi.ToGregorian(era, year, month, day)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing %q: %w", value, err)
+		}
+		year, month, day = t.Year(), int(t.Month()), t.Day()
+	} else if p.bc {
+		year = 1 - year
+	}
+
+	hour := p.hour
+	switch p.hourLetter {
+	case 'h', 'K':
+		if hour > 12 || p.hourLetter == 'K' && hour > 11 {
+			return time.Time{}, fmt.Errorf("parsing %q: hour out of range", value)
+		}
+		hour %= 12
+		if p.pm {
+			hour += 12
+		}
+	case 'k':
+		if hour == 24 {
+			hour = 0
+		}
+	}
+	if hour > 23 || p.minute > 59 || p.second > 59 {
+		return time.Time{}, fmt.Errorf("parsing %q: time out of range", value)
+	}
+
+	if p.yearDay > 0 {
+		t := time.Date(year, time.January, p.yearDay, hour, p.minute, p.second, p.nsec, p.loc)
+		if t.Year() != year {
+			return time.Time{}, fmt.Errorf("parsing %q: day of year out of range", value)
+		}
+		return t, nil
+	}
+	if month < 1 || month > 12 {
+		return time.Time{}, fmt.Errorf("parsing %q: month out of range", value)
+	}
+	t := time.Date(year, time.Month(month), day, hour, p.minute, p.second, p.nsec, p.loc)
+	if day < 1 || t.Day() != day {
+		return time.Time{}, fmt.Errorf("parsing %q: day out of range", value)
+	}
+	if p.cal != nil {
+		// A year past the end of its era belongs to the next one ("Heisei 32")
+		if y, m, d, _ := p.cal.Transform(t); y != p.year || m != p.month || d != p.day {
+			return time.Time{}, fmt.Errorf("parsing %q: day out of range", value)
+		}
+	}
+	return t, nil
 }
@@ -2,6 +2,7 @@ package regional
 
 import (
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -16,8 +17,24 @@ func TestParse(t *testing.T) {
 		{"Parse EU", "25/12/2023 15:30", RegionEU, 2023, false},
 		{"Parse CA", "2023-12-25", RegionCA, 2023, false},
 		{"Parse ID", "25 Desember 2023", RegionID, 2023, false},
-		{"Parse TH", "25/12/2566", RegionTH, 2023, false}, 
+		{"Parse TH", "25/12/2566", RegionTH, 2023, false},
 		{"Parse JP", "2023/12/25", RegionJP, 2023, false},
+		{"Parse ID lowercase", "25 desember 2023", RegionID, 2023, false},
+		{"Parse KR", "2023.12.25", RegionKR, 2023, false},
+		{"Parse ISO", "2023-12-25 15:30:00", RegionISO, 2023, false},
+		{"Parse DE", "25.12.2023", RegionDE, 2023, false},
+		{"Parse NL", "25-12-2023", RegionNL, 2023, false},
+		{"Parse TW", "2023/12/25", RegionTW, 2023, false},
+		{"Parse GB", "25/12/2023", RegionGB, 2023, false},
+		{"Parse IR Persian digits", "۱۴۰۲/۱۰/۰۴", RegionIR, 2023, false},
+		{"Parse IR ASCII digits", "1402/10/04", RegionIR, 2023, false},
+		{"Invalid day", "31/02/2023", RegionVN, 0, true},
+		{"Invalid hour", "12/25/2023 13:30 PM", RegionUS, 0, true},
+		{"Unknown month", "25 Foo 2023", RegionID, 0, true},
+		{"Invalid Persian day", "1402/12/30", RegionIR, 0, true}, // 1402 is not a leap year
+		{"Parse ET Ethiopian", "15/04/2016", RegionET, 2023, false},
+		{"Trailing text", "2023-12-25 extra", RegionCA, 0, true},
+		{"Unknown region", "2023-12-25", Region("xx"), 0, true},
 	}
 
 	for _, tt := range tests {
@@ -33,3 +50,92 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+// TestParse_RoundTrip parses the output of Format for every registered region.
+func TestParse_RoundTrip(t *testing.T) {
+	tm := time.Date(2023, 12, 5, 15, 30, 9, 0, time.UTC)
+
+	for _, region := range Regions() {
+		formatted := Format(tm, region, "", nil)
+		got, err := Parse(formatted, region)
+		if err != nil {
+			t.Errorf("%s: Parse(%q) error: %v", region, formatted, err)
+			continue
+		}
+		if again := Format(got, region, "", nil); again != formatted {
+			t.Errorf("%s: Parse(%q) = %v, formats as %q", region, formatted, got, again)
+		}
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    time.Time
+	}{
+		{"yyyyMMddHHmm", "202312251530", time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)},
+		{"d/M/yy", "5/1/99", time.Date(1999, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"EEEE, d MMMM y", "Monday, 25 December 2023", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"y-MM-dd'T'HH:mm:ss.SSSXXX", "2023-12-25T15:30:00.250+07:00",
+			time.Date(2023, 12, 25, 15, 30, 0, 250e6, time.FixedZone("", 7*3600))},
+		{"y-MM-dd HH:mm O", "2023-12-25 15:30 GMT+7", time.Date(2023, 12, 25, 15, 30, 0, 0, time.FixedZone("", 7*3600))},
+		{"h:mm a", "12:05 AM", time.Date(0, 1, 1, 0, 5, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFields(tt.input, parsePattern(tt.pattern), nil, translate("en"))
+		if err != nil {
+			t.Errorf("parseFields(%q, %q) error: %v", tt.input, tt.pattern, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseFields(%q, %q) = %v, want %v", tt.input, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestParseFields_Calendars(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		lang     string
+		calendar CalendarSystem
+		input    string
+		want     time.Time
+	}{
+		{"d MMMM G y", "th", BuddhistCalendar{}, "25 ธันวาคม พ.ศ. 2566", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"Gy年M月d日", "zh-Hant", MinguoCalendar{}, "民國112年12月25日", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"Gy年M月d日", "zh-Hant", MinguoCalendar{}, "民國前12年3月1日", time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"MMMM d, y G", "en", MinguoCalendar{}, "December 25, 112 Minguo", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y", "fa", PersianCalendar{}, "۴ دی ۱۴۰۲", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y", "fa-AF", PersianCalendar{}, "۴ جدی ۱۴۰۲", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y", "en", HebrewCalendar{}, "14 Adar II 5784", time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)},
+		{"d בMMMM y", "he", HebrewCalendar{}, "14 באדר 5785", time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "id", JavaneseCalendar{}, "1 Sura 1958 AJ", time.Date(2024, 7, 8, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "hi", SakaCalendar{}, "4 पौष 1945 शक", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "en", SakaCalendar{}, "31 Chaitra 1946 Saka", time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y", "am", EthiopianCalendar{}, "6 ጳጉሜን 2015", time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "en", EthiopianCalendar{}, "5 Pagumen 5500 AA", time.Date(8, 8, 26, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "ar", CopticCalendar{}, "1 توت 1740 ش", time.Date(2023, 9, 12, 0, 0, 0, 0, time.UTC)},
+		{"MMMM d, y G", "en", CopticCalendar{}, "Nasie 6, 1739 AM", time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)},
+		{"G y/MM/dd", "ja", JapaneseCalendar{}, "令和 6/05/01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"G y/MM/dd", "en", JapaneseCalendar{}, "Shōwa 64/01/07", time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"Gy年M月d日", "ja", JapaneseCalendar{}, "平成31年4月30日", time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"Gy年M月d日", "ja", JapaneseCalendar{Gannen: true}, "令和元年5月1日", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "id", HijriCalendar{}, "12 Jumadil Akhir 1445 H", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "ar", HijriCalendar{}, "1 رمضان 1445 هـ", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "ar", HijriCalendar{Variant: HijriUmmAlQura}, "1 شوال 1445 هـ", time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)},
+		{"d MMMM y G", "ms", HijriCalendar{Adjustment: -1}, "1 Syawal 1445 H", time.Date(2024, 4, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFields(tt.input, parsePattern(tt.pattern), tt.calendar, translate(tt.lang))
+		if err != nil {
+			t.Errorf("parseFields(%q, %q) error: %v", tt.input, tt.pattern, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseFields(%q, %q) = %v, want %v", tt.input, tt.pattern, got, tt.want)
+		}
+	}
+}
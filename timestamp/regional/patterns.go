@@ -0,0 +1,66 @@
+package regional
+
+// RegionPattern describes the default output of Format for a region in a
+// platform-neutral form, so web and mobile clients can render the same dates.
+type RegionPattern struct {
+	Pattern  string // LDML pattern, e.g. "dd/MM/yyyy HH:mm"
+	Calendar string // CLDR name of the calendar: "gregorian", "buddhist", ... or "custom"
+	Locale   string // smart locale of the region's names, e.g. "en-150"
+}
+
+// Patterns returns the default pattern of every registered region, including
+// those added with RegisterRegion.
+//
+// Example:
+//
+//	p := Patterns()[RegionEU]
+//	fmt.Println(p.Pattern) // Output: dd/MM/yyyy HH:mm
+func Patterns() map[Region]RegionPattern {
+	regionsLock.RLock()
+	defer regionsLock.RUnlock()
+
+	out := make(map[Region]RegionPattern, len(regions))
+	for region, spec := range regions {
+		out[region] = RegionPattern{Pattern: spec.Pattern, Calendar: calendarName(spec.Calendar), Locale: spec.Locale}
+	}
+	return out
+}
+
+// calendarName names a region calendar for clients that only know the
+// built-in ones.
+func calendarName(cal CalendarSystem) string {
+	switch cal := cal.(type) {
+	case nil:
+		return "gregorian"
+	case NamedCalendar:
+		return cal.Name()
+	}
+	return "custom"
+}
+
+// CalendarNamed returns the built-in calendar with a CLDR name reported by
+// Patterns, in its default settings, and nil for "gregorian". "islamic" is
+// HijriCalendar{}, the tabular Type II calendar.
+//
+// Example:
+//
+//	cal, _ := CalendarNamed("buddhist")
+//	fmt.Println(cal.(NamedCalendar).Name()) // Output: buddhist
+func CalendarNamed(name string) (CalendarSystem, bool) {
+	if name == "gregorian" {
+		return nil, true
+	}
+	for _, cal := range builtinCalendars {
+		if cal.Name() == name {
+			return cal, true
+		}
+	}
+	return nil, false
+}
+
+// builtinCalendars lists the calendars CalendarNamed knows by name.
+var builtinCalendars = []NamedCalendar{
+	BuddhistCalendar{}, ChineseCalendar{}, CopticCalendar{}, EthiopianCalendar{}, HebrewCalendar{},
+	HijriCalendar{}, JapaneseCalendar{}, JavaneseCalendar{}, MinguoCalendar{}, PersianCalendar{},
+	SakaCalendar{}, VikramSamvatCalendar{},
+}
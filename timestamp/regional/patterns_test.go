@@ -0,0 +1,52 @@
+package regional
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPatterns_MatchFormat guards against the exported patterns drifting from Format.
+func TestPatterns_MatchFormat(t *testing.T) {
+	tm := time.Date(2023, 12, 5, 15, 30, 9, 0, time.UTC)
+
+	for region, p := range Patterns() {
+		year, month, day := 2023, 12, 5
+		switch p.Calendar {
+		case "buddhist":
+			year = 2566
+		case "persian":
+			year, month, day = 1402, 9, 14 // 14 Azar 1402
+		case "ethiopic":
+			year, month, day = 2016, 3, 25 // 25 Hedar 2016
+		}
+		ldml := strings.NewReplacer(
+			"MMMM", "December", "yyyy", fmt.Sprintf("%04d", year), "y", strconv.Itoa(year),
+			"MM", fmt.Sprintf("%02d", month), "M", strconv.Itoa(month),
+			"dd", fmt.Sprintf("%02d", day), "d", strconv.Itoa(day),
+			"HH", "15", "hh", "03", "mm", "30", "ss", "09", "a", "PM",
+		)
+
+		want := ldml.Replace(p.Pattern)
+		if got := Format(tm, region, LangEN, nil); got != want {
+			t.Errorf("%s: Format() = %q, pattern %q renders %q", region, got, p.Pattern, want)
+		}
+	}
+}
+
+// TestCalendarNamed checks that every calendar name of Patterns resolves.
+func TestCalendarNamed(t *testing.T) {
+	for region, p := range Patterns() {
+		if _, ok := CalendarNamed(p.Calendar); !ok {
+			t.Errorf("%s: CalendarNamed(%q) not found", region, p.Calendar)
+		}
+	}
+	if cal, ok := CalendarNamed("buddhist"); !ok || cal != (BuddhistCalendar{}) {
+		t.Errorf("CalendarNamed(buddhist) = %v, %v", cal, ok)
+	}
+	if _, ok := CalendarNamed("custom"); ok {
+		t.Error("CalendarNamed(custom) found a calendar")
+	}
+}
@@ -0,0 +1,264 @@
+package regional
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// HourCycle is the clock convention of a region, named as in CLDR and
+// Intl.DateTimeFormat's hourCycle option.
+type HourCycle string
+
+const (
+	HourCycle11 HourCycle = "h11" // 0-11 with a day period ("午後3時")
+	HourCycle12 HourCycle = "h12" // 1-12 with a day period ("3:30 PM")
+	HourCycle23 HourCycle = "h23" // 0-23 ("15:30")
+	HourCycle24 HourCycle = "h24" // 1-24
+)
+
+// letter returns the LDML hour field letter of the cycle.
+func (hc HourCycle) letter() byte {
+	switch hc {
+	case HourCycle11:
+		return 'K'
+	case HourCycle12:
+		return 'h'
+	case HourCycle24:
+		return 'k'
+	}
+	return 'H'
+}
+
+// RegionSpec describes how a region writes dates. Format and Parse use Pattern;
+// skeletons (FormatPattern) and styles (FormatStyle) use the CLDR data of
+// Locale, with Patterns overriding individual keys.
+type RegionSpec struct {
+	Pattern          string            // Default LDML pattern of Format and Parse, e.g. "dd/MM/y HH:mm"
+	CalendarPattern  string            // Pattern of Format when a CalendarSystem is passed, e.g. "G y-MM-dd"; empty uses the "GyMMMMd" skeleton
+	CalendarPatterns map[string]string // CalendarPattern of the languages whose words it doesn't fit, e.g. "he": "d בMMMM y"
+	Locale           string            // smart locale of the region's patterns and names, e.g. "en-150"
+	Calendar         CalendarSystem    // Default calendar of the date fields; nil for Gregorian
+	HourCycle        HourCycle         // Clock used for "j" in skeletons; empty follows Locale's short time
+	Patterns         map[string]string // Overrides of Locale's "pattern_" keys, e.g. "pattern_date_long"
+}
+
+var (
+	regions = map[Region]RegionSpec{
+		RegionISO: {
+			Pattern:         "yyyy-MM-dd HH:mm:ss",
+			CalendarPattern: "G y-MM-dd",
+			Locale:          "en",
+			HourCycle:       HourCycle23,
+			Patterns: map[string]string{
+				"pattern_datetime":      "{1} {0}",
+				"pattern_skeleton_Md":   "MM-dd",
+				"pattern_skeleton_MEd":  "E MM-dd",
+				"pattern_skeleton_yM":   "yyyy-MM",
+				"pattern_skeleton_yMd":  "yyyy-MM-dd",
+				"pattern_skeleton_yMEd": "E yyyy-MM-dd",
+				"pattern_date_medium":   "yyyy-MM-dd",
+				"pattern_date_short":    "yyyy-MM-dd",
+				"pattern_time_full":     "HH:mm:ss XXX",
+				"pattern_time_long":     "HH:mm:ss XXX",
+				"pattern_time_medium":   "HH:mm:ss",
+				"pattern_time_short":    "HH:mm",
+			},
+		},
+		RegionUS: {Pattern: "MM/dd/yyyy hh:mm a", Locale: "en", HourCycle: HourCycle12},
+		RegionEU: {Pattern: "dd/MM/yyyy HH:mm", Locale: "en-150", HourCycle: HourCycle23},
+		RegionCA: {Pattern: "yyyy-MM-dd", Locale: "en-CA", HourCycle: HourCycle12},
+
+		RegionID: {
+			Pattern:   "dd MMMM y",
+			Locale:    "id",
+			HourCycle: HourCycle23,
+			Patterns: map[string]string{
+				// "14.30 WIB": dot separator, zone abbreviation (WIB, WITA, WIT)
+				"pattern_skeleton_Hm":  "HH.mm",
+				"pattern_skeleton_Hms": "HH.mm.ss",
+				"pattern_time_short":   "HH.mm z",
+				"pattern_time_medium":  "HH.mm.ss z",
+			},
+		},
+		RegionTH: {
+			Pattern:   "dd/MM/yyyy",
+			Locale:    "th",
+			Calendar:  BuddhistCalendar{},
+			HourCycle: HourCycle23,
+			Patterns: map[string]string{
+				// Buddhist Era years; the era name is usually left out
+				"pattern_date_full":      "EEEEที่ d MMMM y",
+				"pattern_date_long":      "d MMMM y",
+				"pattern_skeleton_yMMMM": "MMMM y",
+				// "14.30 น." (นาฬิกา, o'clock)
+				"pattern_skeleton_Hm":  "HH.mm น.",
+				"pattern_skeleton_Hms": "HH.mm.ss น.",
+				"pattern_time_short":   "HH.mm น.",
+				"pattern_time_medium":  "HH.mm.ss น.",
+			},
+		},
+		RegionVN: {Pattern: "dd/MM/yyyy", Locale: "vi", HourCycle: HourCycle23},
+		RegionMY: {Pattern: "dd/MM/yyyy", Locale: "ms", HourCycle: HourCycle12},
+		RegionSG: {Pattern: "dd/MM/yyyy", Locale: "en-SG", HourCycle: HourCycle12},
+		RegionPH: {Pattern: "MM/dd/yyyy", Locale: "en", HourCycle: HourCycle12},
+
+		RegionJP: {
+			Pattern:          "yyyy/MM/dd",
+			CalendarPattern:  "G y/MM/dd",                        // "Reiwa 6/05/01"
+			CalendarPatterns: map[string]string{"ja": "Gy年M月d日"}, // "令和6年5月1日"
+			Locale:           "ja",
+			HourCycle:        HourCycle11,
+			Patterns: map[string]string{
+				"pattern_date_long": "y年M月d日(E)",
+				// "午後3時30分": day period with a 12-hour clock starting at 0
+				"pattern_skeleton_hm":  "aK時mm分",
+				"pattern_skeleton_hms": "aK時mm分ss秒",
+				"pattern_time_short":   "aK時mm分",
+				"pattern_time_medium":  "aK時mm分ss秒",
+			},
+		},
+		RegionKR: {Pattern: "yyyy.MM.dd", Locale: "ko", HourCycle: HourCycle12},
+		RegionCN: {Pattern: "yyyy-MM-dd", Locale: "zh", HourCycle: HourCycle23},
+		RegionTW: {Pattern: "yyyy/MM/dd", Locale: "zh-Hant", HourCycle: HourCycle12},
+		RegionHK: {Pattern: "dd/MM/yyyy", Locale: "zh-Hant-HK", HourCycle: HourCycle12},
+
+		RegionIN: {Pattern: "dd/MM/yyyy", Locale: "en-IN", HourCycle: HourCycle12},
+
+		RegionGB: {Pattern: "dd/MM/yyyy", Locale: "en-GB", HourCycle: HourCycle23},
+		RegionDE: {Pattern: "dd.MM.yyyy", Locale: "de", HourCycle: HourCycle23},
+		RegionFR: {Pattern: "dd/MM/yyyy", Locale: "fr", HourCycle: HourCycle23},
+		RegionNL: {Pattern: "dd-MM-yyyy", Locale: "nl", HourCycle: HourCycle23},
+		RegionES: {Pattern: "dd/MM/yyyy", Locale: "es", HourCycle: HourCycle23},
+		RegionIT: {Pattern: "dd/MM/yyyy", Locale: "it", HourCycle: HourCycle23},
+		RegionTR: {Pattern: "dd.MM.yyyy", Locale: "tr", HourCycle: HourCycle23},
+
+		RegionBR: {Pattern: "dd/MM/yyyy", Locale: "pt", HourCycle: HourCycle23},
+		RegionMX: {Pattern: "dd/MM/yyyy", Locale: "es-MX", HourCycle: HourCycle23},
+
+		// Gregorian dates, as used in business; Hijri dates need a CalendarSystem
+		RegionSA: {Pattern: "dd/MM/yyyy", Locale: "ar", HourCycle: HourCycle12},
+		RegionAE: {Pattern: "dd/MM/yyyy", Locale: "ar", HourCycle: HourCycle12},
+
+		// Solar Hijri dates in Persian digits: "۱۴۰۲/۱۰/۰۴", "۴ دی ۱۴۰۲"
+		RegionIR: {
+			Pattern:         "yyyy/MM/dd",
+			CalendarPattern: "d MMMM y",
+			Locale:          "fa",
+			Calendar:        PersianCalendar{},
+			HourCycle:       HourCycle23,
+		},
+		RegionAF: {
+			Pattern:         "yyyy/MM/dd",
+			CalendarPattern: "d MMMM y",
+			Locale:          "fa-AF",
+			Calendar:        PersianCalendar{},
+			HourCycle:       HourCycle23,
+		},
+		// Gregorian dates; Hebrew dates need a CalendarSystem ("13 בטבת 5784")
+		RegionIL: {
+			Pattern:          "d.M.yyyy",
+			CalendarPattern:  "d MMMM y",
+			CalendarPatterns: map[string]string{"he": "d בMMMM y"}, // The month takes the prefix ב ("in") in Hebrew only
+			Locale:           "he",
+			HourCycle:        HourCycle23,
+		},
+
+		// Gregorian dates; Coptic dates need a CalendarSystem ("15 كيهك 1740 ش")
+		RegionEG: {Pattern: "dd/MM/yyyy", CalendarPattern: "d MMMM y G", Locale: "ar", HourCycle: HourCycle12},
+		// Ethiopian dates: "15/04/2016", "15 ታኅሣሥ 2016"
+		RegionET: {
+			Pattern:         "dd/MM/yyyy",
+			CalendarPattern: "d MMMM y",
+			Locale:          "am",
+			Calendar:        EthiopianCalendar{},
+			HourCycle:       HourCycle12,
+		},
+
+		RegionAU: {Pattern: "dd/MM/yyyy", Locale: "en-AU", HourCycle: HourCycle12},
+		RegionNZ: {Pattern: "dd/MM/yyyy", Locale: "en-NZ", HourCycle: HourCycle12},
+	}
+	regionsLock sync.RWMutex
+)
+
+// RegisterRegion adds or replaces a region, so applications can support their
+// own markets without forking. Pattern and Locale are required.
+//
+// Example:
+//
+//	err := RegisterRegion("ke", RegionSpec{
+//		Pattern:   "d/MM/y h:mm a",
+//		Locale:    "en",
+//		HourCycle: HourCycle12,
+//		Patterns:  map[string]string{"pattern_skeleton_yMd": "d/MM/y"},
+//	})
+func RegisterRegion(region Region, spec RegionSpec) error {
+	if err := ValidateRegion(region, spec); err != nil {
+		return err
+	}
+
+	regionsLock.Lock()
+	defer regionsLock.Unlock()
+	regions[region] = spec
+	return nil
+}
+
+// UnregisterRegion removes a region, such as one added with RegisterRegion;
+// Format then writes its dates as RFC 3339.
+func UnregisterRegion(region Region) {
+	regionsLock.Lock()
+	defer regionsLock.Unlock()
+	delete(regions, region)
+}
+
+// ValidateRegion returns the error RegisterRegion would return for region and
+// spec, without registering it, so several regions can be checked before any
+// is added.
+func ValidateRegion(region Region, spec RegionSpec) error {
+	if region == "" {
+		return fmt.Errorf("region code is required")
+	}
+	if spec.Pattern == "" {
+		return fmt.Errorf("region %s: pattern is required", region)
+	}
+	if spec.Locale == "" {
+		return fmt.Errorf("region %s: locale is required", region)
+	}
+	if err := validatePattern(spec.Pattern); err != nil {
+		return fmt.Errorf("region %s: %w", region, err)
+	}
+	return nil
+}
+
+// LookupRegion returns the specification of a registered region.
+func LookupRegion(region Region) (RegionSpec, bool) {
+	regionsLock.RLock()
+	defer regionsLock.RUnlock()
+	spec, ok := regions[region]
+	return spec, ok
+}
+
+// Regions returns the codes of every registered region, sorted.
+func Regions() []Region {
+	regionsLock.RLock()
+	defer regionsLock.RUnlock()
+
+	codes := make([]Region, 0, len(regions))
+	for region := range regions {
+		codes = append(codes, region)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	return codes
+}
+
+// specFor returns the spec of region, or a spec following lang (EN when
+// empty) for regions that aren't registered.
+func specFor(region Region, lang string) RegionSpec {
+	if spec, ok := LookupRegion(region); ok {
+		return spec
+	}
+	if lang == "" {
+		lang = "en"
+	}
+	return RegionSpec{Locale: lang}
+}
@@ -0,0 +1,61 @@
+package regional
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegisterRegion(t *testing.T) {
+	const ke Region = "ke"
+	t.Cleanup(func() {
+		regionsLock.Lock()
+		delete(regions, ke)
+		regionsLock.Unlock()
+	})
+
+	err := RegisterRegion(ke, RegionSpec{
+		Pattern:   "d/MM/y h:mm a",
+		Locale:    "en",
+		HourCycle: HourCycle12,
+		Patterns:  map[string]string{"pattern_skeleton_yMd": "d/MM/y"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterRegion() error: %v", err)
+	}
+
+	tm := time.Date(2023, 12, 5, 15, 30, 0, 0, time.UTC)
+	if got := Format(tm, ke, "", nil); got != "5/12/2023 3:30 PM" {
+		t.Errorf("Format() = %q, want %q", got, "5/12/2023 3:30 PM")
+	}
+	if got := FormatPattern(tm, "yMd", ke, ""); got != "5/12/2023" {
+		t.Errorf("FormatPattern(yMd) = %q, want %q", got, "5/12/2023")
+	}
+	if got, err := Parse("5/12/2023 3:30 PM", ke); err != nil || !got.Equal(tm) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, tm)
+	}
+	if p := Patterns()[ke]; p.Pattern != "d/MM/y h:mm a" || p.Calendar != "gregorian" {
+		t.Errorf("Patterns()[ke] = %+v", p)
+	}
+}
+
+func TestRegisterRegion_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		region Region
+		spec   RegionSpec
+	}{
+		{"no code", "", RegionSpec{Pattern: "y-MM-dd", Locale: "en"}},
+		{"no pattern", "xx", RegionSpec{Locale: "en"}},
+		{"no locale", "xx", RegionSpec{Pattern: "y-MM-dd"}},
+		{"unsupported field", "xx", RegionSpec{Pattern: "y-MM-dd QQQ", Locale: "en"}},
+	}
+
+	for _, tt := range tests {
+		if err := RegisterRegion(tt.region, tt.spec); err == nil {
+			t.Errorf("%s: RegisterRegion() returned no error", tt.name)
+		}
+	}
+	if _, ok := LookupRegion("xx"); ok {
+		t.Error("invalid region was registered")
+	}
+}
@@ -0,0 +1,143 @@
+package regional
+
+import (
+	"strings"
+	"time"
+)
+
+// Style selects how much detail FormatStyle shows for the date or the time,
+// like the dateStyle and timeStyle options of Intl.DateTimeFormat.
+type Style int
+
+const (
+	StyleNone   Style = iota // Omit the date or time
+	StyleShort               // 12/25/23, 3:30 PM
+	StyleMedium              // Dec 25, 2023, 3:30:00 PM
+	StyleLong                // December 25, 2023, 3:30:00 PM UTC
+	StyleFull                // Monday, December 25, 2023, 3:30:00 PM GMT
+)
+
+var styleNames = [...]string{"none", "short", "medium", "long", "full"}
+
+// String returns the CLDR name of the style ("short", "medium", ...).
+func (s Style) String() string {
+	if s < StyleNone || s > StyleFull {
+		return styleNames[StyleNone]
+	}
+	return styleNames[s]
+}
+
+// FormatStyle formats t with the region's date and time patterns for the given
+// styles; either part is omitted with StyleNone. With both set to StyleNone the
+// short date is used. Names come from lang, or from the region's language when
+// lang is empty or has no registered locale.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 15, 30, 0, 0, time.UTC)
+//	fmt.Println(FormatStyle(t, RegionEU, "", StyleFull, StyleNone))   // Output: Monday, 25 December 2023
+//	fmt.Println(FormatStyle(t, RegionJP, "", StyleLong, StyleNone))   // Output: 2023年12月25日(月)
+//	fmt.Println(FormatStyle(t, RegionUS, "", StyleShort, StyleShort)) // Output: 12/25/23, 3:30 PM
+func FormatStyle(t time.Time, region Region, lang string, dateStyle, timeStyle Style) string {
+	return FormatCalendarStyle(t, region, lang, dateStyle, timeStyle, nil)
+}
+
+// FormatCalendarStyle is FormatStyle with the date in calendar, or in the
+// region's calendar when it is nil. Like Format, it names the era of a date in
+// another calendar, next to the year of the region's style pattern.
+//
+// Example:
+//
+//	t := time.Date(2023, time.December, 25, 15, 30, 0, 0, time.UTC)
+//	fmt.Println(FormatCalendarStyle(t, RegionID, "", StyleLong, StyleNone, HijriCalendar{})) // Output: 12 Jumadil Akhir 1445 H
+func FormatCalendarStyle(t time.Time, region Region, lang string, dateStyle, timeStyle Style, calendar CalendarSystem) string {
+	spec := specFor(region, lang)
+	lang = spec.language(lang)
+	pattern, cal := spec.stylePattern(dateStyle, timeStyle), spec.Calendar
+	if calendar != nil {
+		pattern, cal = spec.calendarStylePattern(dateStyle, timeStyle), calendar
+	}
+	return render(t, cal, pattern, lang)
+}
+
+// StylePattern returns the LDML pattern FormatStyle uses for region and styles.
+//
+// Example:
+//
+//	fmt.Println(StylePattern(RegionEU, "", StyleMedium, StyleShort)) // Output: d MMM y, HH:mm
+func StylePattern(region Region, lang string, dateStyle, timeStyle Style) string {
+	return specFor(region, lang).stylePattern(dateStyle, timeStyle)
+}
+
+func (spec RegionSpec) stylePattern(dateStyle, timeStyle Style) string {
+	return spec.joinStyles(dateStyle, timeStyle, func(style Style) string {
+		return spec.pattern("pattern_date_" + style.String())
+	})
+}
+
+// calendarStylePattern is stylePattern for dates in another calendar, whose
+// date patterns name the era.
+func (spec RegionSpec) calendarStylePattern(dateStyle, timeStyle Style) string {
+	return spec.joinStyles(dateStyle, timeStyle, func(style Style) string {
+		return withEra(spec.pattern("pattern_date_" + style.String()))
+	})
+}
+
+// withEra adds the era to a date pattern that has none: after the year
+// ("d MMMM y G"), or before it when the year comes first or is followed by 年
+// ("G y-MM-dd", "Gy年M月d日"). The year is written in full, unpadded.
+func withEra(pattern string) string {
+	fields := parsePattern(pattern)
+	for _, f := range fields {
+		if f.letter == 'G' {
+			return pattern
+		}
+	}
+
+	var b strings.Builder
+	added := false
+	for i, f := range fields {
+		if f.letter == 0 {
+			b.WriteString(quoteLiteral(f.literal))
+			continue
+		}
+		if f.letter != 'y' || added {
+			b.WriteString(strings.Repeat(string(f.letter), f.width))
+			continue
+		}
+		added = true
+		kanji := i+1 < len(fields) && strings.HasPrefix(fields[i+1].literal, "年")
+		switch {
+		case kanji:
+			b.WriteString("Gy")
+		case i == 0:
+			b.WriteString("G y")
+		default:
+			b.WriteString("y G")
+		}
+	}
+	return b.String()
+}
+
+// joinStyles joins the date pattern of dateStyle with the time pattern of
+// timeStyle, leaving out StyleNone; with both StyleNone it is the short date.
+func (spec RegionSpec) joinStyles(dateStyle, timeStyle Style, datePattern func(Style) string) string {
+	if dateStyle == StyleNone && timeStyle == StyleNone {
+		dateStyle = StyleShort
+	}
+
+	var date, clock string
+	if dateStyle != StyleNone {
+		date = datePattern(dateStyle)
+	}
+	if timeStyle != StyleNone {
+		clock = spec.pattern("pattern_time_" + timeStyle.String())
+	}
+	switch {
+	case clock == "":
+		return date
+	case date == "":
+		return clock
+	}
+	return spec.joinDateTime(date, clock)
+}
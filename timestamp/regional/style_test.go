@@ -0,0 +1,171 @@
+package regional
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatStyle(t *testing.T) {
+	// Fixed date: 2023-12-25 15:30:00 UTC (a Monday)
+	fixedTime := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		region    Region
+		dateStyle Style
+		timeStyle Style
+		expected  string
+	}{
+		{"EU full date", RegionEU, StyleFull, StyleNone, "Monday, 25 December 2023"},
+		{"EU short date and time", RegionEU, StyleShort, StyleShort, "25/12/2023, 15:30"},
+		{"US medium date and time", RegionUS, StyleMedium, StyleMedium, "Dec 25, 2023, 3:30:00 PM"},
+		{"US short date", RegionUS, StyleShort, StyleNone, "12/25/23"},
+		{"US time only", RegionUS, StyleNone, StyleShort, "3:30 PM"},
+		{"Default is short date", RegionUS, StyleNone, StyleNone, "12/25/23"},
+		{"CA short date", RegionCA, StyleShort, StyleNone, "2023-12-25"},
+		{"ISO medium date and time", RegionISO, StyleMedium, StyleMedium, "2023-12-25 15:30:00"},
+		{"ID long date", RegionID, StyleLong, StyleNone, "25 Desember 2023"},
+		{"ID full date", RegionID, StyleFull, StyleNone, "Senin, 25 Desember 2023"},
+		{"VN short date and time", RegionVN, StyleShort, StyleShort, "15:30 25/12/2023"},
+		{"MY medium date", RegionMY, StyleMedium, StyleNone, "25 Dis 2023"},
+		{"SG long date", RegionSG, StyleLong, StyleNone, "25 December 2023"},
+		{"JP long date", RegionJP, StyleLong, StyleNone, "2023年12月25日(月)"},
+		{"JP short date and time", RegionJP, StyleShort, StyleShort, "2023/12/25 午後3時30分"},
+		{"KR short date", RegionKR, StyleShort, StyleNone, "23. 12. 25."},
+		{"CN short date and time", RegionCN, StyleShort, StyleShort, "2023/12/25 15:30"},
+		{"TW short date and time", RegionTW, StyleShort, StyleShort, "2023/12/25 下午3:30"},
+		{"HK short date", RegionHK, StyleShort, StyleNone, "25/12/2023"},
+		{"IN short date and time", RegionIN, StyleShort, StyleShort, "25/12/23, 3:30 PM"},
+		{"GB short date and time", RegionGB, StyleShort, StyleShort, "25/12/2023, 15:30"},
+		{"DE long date", RegionDE, StyleLong, StyleNone, "25. Dezember 2023"},
+		{"FR full date", RegionFR, StyleFull, StyleNone, "lundi 25 décembre 2023"},
+		{"NL short date", RegionNL, StyleShort, StyleNone, "25-12-2023"},
+		{"ES long date", RegionES, StyleLong, StyleNone, "25 de diciembre de 2023"},
+		{"IT medium date", RegionIT, StyleMedium, StyleNone, "25 dic 2023"},
+		{"TR full date", RegionTR, StyleFull, StyleNone, "25 Aralık 2023 Pazartesi"},
+		{"BR long date", RegionBR, StyleLong, StyleNone, "25 de dezembro de 2023"},
+		{"MX short date and time", RegionMX, StyleShort, StyleShort, "25/12/23, 15:30"},
+		{"SA long date", RegionSA, StyleLong, StyleNone, "25 ديسمبر 2023"},
+		{"AE time", RegionAE, StyleNone, StyleShort, "3:30 م"},
+		{"AU short date and time", RegionAU, StyleShort, StyleShort, "25/12/23, 3:30 PM"},
+		{"NZ medium date", RegionNZ, StyleMedium, StyleNone, "25/12/2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatStyle(fixedTime, tt.region, "", tt.dateStyle, tt.timeStyle)
+			if got != tt.expected {
+				t.Errorf("FormatStyle(%s, %v, %v) = %q, want %q", tt.region, tt.dateStyle, tt.timeStyle, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStylePattern(t *testing.T) {
+	if got := StylePattern(RegionEU, "", StyleMedium, StyleShort); got != "d MMM y, HH:mm" {
+		t.Errorf("StylePattern(EU) = %q, want %q", got, "d MMM y, HH:mm")
+	}
+	// A region without a locale of its own follows the language
+	if got := StylePattern("", "de", StyleShort, StyleNone); got != "dd.MM.yy" {
+		t.Errorf("StylePattern(de) = %q, want %q", got, "dd.MM.yy")
+	}
+}
+
+func TestFormatStyle_NativeNames(t *testing.T) {
+	fixedTime := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		region    Region
+		dateStyle Style
+		expected  string
+	}{
+		{RegionTH, StyleLong, "25 ธันวาคม 2566"},
+		{RegionTH, StyleFull, "วันจันทร์ที่ 25 ธันวาคม 2566"},
+		{RegionVN, StyleLong, "25 tháng 12, 2023"},
+		{RegionMY, StyleFull, "Isnin, 25 Disember 2023"},
+		{RegionID, StyleMedium, "25 Des 2023"},
+		{RegionJP, StyleFull, "2023年12月25日月曜日"},
+		{RegionKR, StyleFull, "2023년 12월 25일 월요일"},
+		{RegionCN, StyleFull, "2023年12月25日星期一"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.region)+"/"+tt.dateStyle.String(), func(t *testing.T) {
+			got := FormatStyle(fixedTime, tt.region, "", tt.dateStyle, StyleNone)
+			if got != tt.expected {
+				t.Errorf("FormatStyle(%s, %v) = %q, want %q", tt.region, tt.dateStyle, got, tt.expected)
+			}
+		})
+	}
+
+	// Day periods are localized too
+	if got := FormatStyle(fixedTime, RegionKR, "", StyleNone, StyleShort); got != "오후 3:30" {
+		t.Errorf("FormatStyle(KR time) = %q, want %q", got, "오후 3:30")
+	}
+}
+
+func TestFormatCalendarStyle(t *testing.T) {
+	fixedTime := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		region    Region
+		dateStyle Style
+		timeStyle Style
+		calendar  CalendarSystem
+		expected  string
+	}{
+		{RegionID, StyleLong, StyleNone, HijriCalendar{}, "12 Jumadil Akhir 1445 H"},
+		{RegionID, StyleFull, StyleNone, HijriCalendar{}, "Senin, 12 Jumadil Akhir 1445 H"},
+		{RegionUS, StyleShort, StyleNone, HijriCalendar{}, "6/12/1445 AH"},
+		{RegionISO, StyleShort, StyleNone, HijriCalendar{}, "AH 1445-06-12"},
+		{RegionJP, StyleLong, StyleShort, JapaneseCalendar{}, "令和5年12月25日(月) 午後3時30分"},
+		{RegionTH, StyleLong, StyleNone, nil, "25 ธันวาคม 2566"}, // The region's calendar, without an era
+	}
+
+	for _, tt := range tests {
+		got := FormatCalendarStyle(fixedTime, tt.region, "", tt.dateStyle, tt.timeStyle, tt.calendar)
+		if got != tt.expected {
+			t.Errorf("FormatCalendarStyle(%s, %v, %v) = %q, want %q", tt.region, tt.dateStyle, tt.timeStyle, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatStyle_NativeTime(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("tzdata not available:", err)
+	}
+	fixedTime := time.Date(2023, 12, 25, 15, 30, 0, 0, jakarta)
+
+	tests := []struct {
+		region    Region
+		timeStyle Style
+		expected  string
+	}{
+		{RegionTH, StyleShort, "15.30 น."},
+		{RegionTH, StyleMedium, "15.30.00 น."},
+		{RegionJP, StyleShort, "午後3時30分"},
+		{RegionJP, StyleMedium, "午後3時30分00秒"},
+		{RegionKR, StyleShort, "오후 3:30"},
+		{RegionID, StyleShort, "15.30 WIB"},
+		{RegionUS, StyleShort, "3:30 PM"},
+		{RegionEU, StyleShort, "15:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.region)+"/"+tt.timeStyle.String(), func(t *testing.T) {
+			got := FormatStyle(fixedTime, tt.region, "", StyleNone, tt.timeStyle)
+			if got != tt.expected {
+				t.Errorf("FormatStyle(%s, %v) = %q, want %q", tt.region, tt.timeStyle, got, tt.expected)
+			}
+		})
+	}
+
+	// Skeletons pick up the same conventions
+	if got := FormatPattern(fixedTime, "jm", RegionJP, ""); got != "午後3時30分" {
+		t.Errorf("FormatPattern(JP, jm) = %q, want %q", got, "午後3時30分")
+	}
+	if got := FormatPattern(fixedTime, "Hm", RegionTH, ""); got != "15.30 น." {
+		t.Errorf("FormatPattern(TH, Hm) = %q, want %q", got, "15.30 น.")
+	}
+}
@@ -1,6 +1,7 @@
 package smart
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -12,6 +13,15 @@ import (
 // - Same year: "DD Mon"
 // - Older: "DD Mon YYYY"
 func Adaptive(t time.Time, lang string) string {
+	return defaultFormatter.Adaptive(t, lang)
+}
+
+// Adaptive is like the package-level Adaptive but resolves strings through f's Translator.
+func (f Formatter) Adaptive(t time.Time, lang string) string {
+	return pseudoBracket(lang, f.adaptive(t, lang))
+}
+
+func (f Formatter) adaptive(t time.Time, lang string) string {
 	now := time.Now().In(t.Location())
 	diff := now.Sub(t)
 	
@@ -23,7 +33,7 @@ func Adaptive(t time.Time, lang string) string {
 	// Just handle past for "Smart" typical use case (messages, feeds)
 	// < 1 min: Just now
 	if diff < time.Minute && diff > -time.Minute {
-		return Social(t, lang, StyleStandard) 
+		return f.social(t, lang, StyleStandard) 
 	}
 	
 	// < 24 hours: HH:MM
@@ -36,15 +46,13 @@ func Adaptive(t time.Time, lang string) string {
 	// < 7 days: Day Name (Monday, etc)
 	// We need localized day names if not English
 	if diff < 7*24*time.Hour && diff > 0 {
-		return t.Format("Monday") 
-		// Note: Go doesn't natively localize "Monday". 
-		// If we want localized, we need a map. 
-		// Let's stick to English for simplicity in this MVP or add map.
+		return f.trans(lang, weekdayKey(t.Weekday()))
 	}
 	
+	month := f.trans(lang, monthShortKey(t.Month()))
 	if now.Year() == t.Year() {
-		return t.Format("02 Jan")
+		return fmt.Sprintf("%02d %s", t.Day(), month)
 	}
 	
-	return t.Format("02 Jan 2006")
+	return fmt.Sprintf("%02d %s %d", t.Day(), month, t.Year())
 }
@@ -0,0 +1,77 @@
+package smart
+
+import "fmt"
+
+//go:generate go run ./internal/cldrgen -src cldr -out cldr_data.go
+
+// registerCLDR merges the generated CLDR tables (cldr_data.go) into the registry.
+// Hand-written locales take precedence: CLDR only fills the dictionary keys
+// and units they don't define, and supplies the plural rule source.
+// Languages without a hand-written locale are registered from CLDR alone.
+func registerCLDR() {
+	for _, gen := range cldrLocales {
+		loc, ok := registry[gen.Code]
+		if !ok {
+			loc = Locale{Code: gen.Code}
+		}
+		registry[gen.Code] = mergeLocale(loc, gen)
+	}
+}
+
+// mergeLocale fills the gaps of base with the data of gen.
+func mergeLocale(base, gen Locale) Locale {
+	if base.PluralRules == nil {
+		base.PluralRules = gen.PluralRules
+	}
+	if base.PluralRule == nil && base.PluralRules != nil {
+		rule, err := CompilePluralRules(base.PluralRules)
+		if err != nil {
+			panic(fmt.Sprintf("smart: locale %s: %v", base.Code, err))
+		}
+		base.PluralRule = rule
+	}
+
+	if base.Dictionary == nil {
+		base.Dictionary = map[string]string{}
+	}
+	for key, val := range gen.Dictionary {
+		if _, ok := base.Dictionary[key]; !ok {
+			base.Dictionary[key] = val
+		}
+	}
+
+	// Units are taken whole: mixing hand-written words with generated phrases
+	// for the same unit would produce inconsistent wording.
+	hasUnit := func(unit string) bool {
+		_, plural := base.Plurals[unit]
+		_, forms := base.Forms[unit]
+		return plural || forms
+	}
+	var genUnits []string
+	for unit := range gen.Plurals {
+		genUnits = append(genUnits, unit)
+	}
+	for unit := range gen.Forms {
+		if _, ok := gen.Plurals[unit]; !ok {
+			genUnits = append(genUnits, unit)
+		}
+	}
+	for _, unit := range genUnits {
+		if hasUnit(unit) {
+			continue
+		}
+		if forms, ok := gen.Plurals[unit]; ok {
+			if base.Plurals == nil {
+				base.Plurals = map[string]map[PluralCategory]string{}
+			}
+			base.Plurals[unit] = forms
+		}
+		if forms, ok := gen.Forms[unit]; ok {
+			if base.Forms == nil {
+				base.Forms = map[string]map[GrammaticalContext]map[PluralCategory]string{}
+			}
+			base.Forms[unit] = forms
+		}
+	}
+	return base
+}
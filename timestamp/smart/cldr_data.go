@@ -0,0 +1,3734 @@
+// Code generated by cldrgen from a CLDR JSON snapshot; DO NOT EDIT.
+
+package smart
+
+var cldrLocales = []Locale{
+	{
+		Code: "am",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 0 or n = 1",
+		},
+		Dictionary: map[string]string{
+			"am":                      "ጥዋት",
+			"d":                       "{0}ቀ",
+			"era_0":                   "ዓ/ዓ",
+			"era_1":                   "ዓ/ም",
+			"ethiopic_era_0":          "ዓ/ዓ",
+			"ethiopic_era_1":          "ዓ/ም",
+			"ethiopic_month_1":        "መስከረም",
+			"ethiopic_month_10":       "ሰኔ",
+			"ethiopic_month_11":       "ሐምሌ",
+			"ethiopic_month_12":       "ነሐሴ",
+			"ethiopic_month_13":       "ጳጉሜን",
+			"ethiopic_month_2":        "ጥቅምት",
+			"ethiopic_month_3":        "ኅዳር",
+			"ethiopic_month_4":        "ታኅሣሥ",
+			"ethiopic_month_5":        "ጥር",
+			"ethiopic_month_6":        "የካቲት",
+			"ethiopic_month_7":        "መጋቢት",
+			"ethiopic_month_8":        "ሚያዝያ",
+			"ethiopic_month_9":        "ግንቦት",
+			"ethiopic_month_short_1":  "መስከ",
+			"ethiopic_month_short_10": "ሰኔ",
+			"ethiopic_month_short_11": "ሐምሌ",
+			"ethiopic_month_short_12": "ነሐሴ",
+			"ethiopic_month_short_13": "ጳጉሜ",
+			"ethiopic_month_short_2":  "ጥቅም",
+			"ethiopic_month_short_3":  "ኅዳር",
+			"ethiopic_month_short_4":  "ታኅሣ",
+			"ethiopic_month_short_5":  "ጥር",
+			"ethiopic_month_short_6":  "የካቲ",
+			"ethiopic_month_short_7":  "መጋቢ",
+			"ethiopic_month_short_8":  "ሚያዝ",
+			"ethiopic_month_short_9":  "ግንቦ",
+			"h":                       "{0}ሰ",
+			"just_now":                "አሁን",
+			"m":                       "{0}ደ",
+			"month_1":                 "ጃንዩወሪ",
+			"month_10":                "ኦክቶበር",
+			"month_11":                "ኖቬምበር",
+			"month_12":                "ዲሴምበር",
+			"month_2":                 "ፌብሩወሪ",
+			"month_3":                 "ማርች",
+			"month_4":                 "ኤፕሪል",
+			"month_5":                 "ሜይ",
+			"month_6":                 "ጁን",
+			"month_7":                 "ጁላይ",
+			"month_8":                 "ኦገስት",
+			"month_9":                 "ሴፕቴምበር",
+			"month_short_1":           "ጃን",
+			"month_short_10":          "ኦክቶ",
+			"month_short_11":          "ኖቬም",
+			"month_short_12":          "ዲሴም",
+			"month_short_2":           "ፌብ",
+			"month_short_3":           "ማርች",
+			"month_short_4":           "ኤፕሪ",
+			"month_short_5":           "ሜይ",
+			"month_short_6":           "ጁን",
+			"month_short_7":           "ጁላይ",
+			"month_short_8":           "ኦገስ",
+			"month_short_9":           "ሴፕቴ",
+			"month_standalone_1":      "ጃንዩወሪ",
+			"month_standalone_10":     "ኦክቶበር",
+			"month_standalone_11":     "ኖቬምበር",
+			"month_standalone_12":     "ዲሴምበር",
+			"month_standalone_2":      "ፌብሩወሪ",
+			"month_standalone_3":      "ማርች",
+			"month_standalone_4":      "ኤፕሪል",
+			"month_standalone_5":      "ሜይ",
+			"month_standalone_6":      "ጁን",
+			"month_standalone_7":      "ጁላይ",
+			"month_standalone_8":      "ኦገስት",
+			"month_standalone_9":      "ሴፕቴምበር",
+			"pattern_date_full":       "y MMMM d, EEEE",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, M/d",
+			"pattern_skeleton_MMMEd":  "E, MMM d",
+			"pattern_skeleton_MMMMd":  "MMMM d",
+			"pattern_skeleton_MMMd":   "MMM d",
+			"pattern_skeleton_Md":     "M/d",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, MMM d, y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "h:mm:ss a zzzz",
+			"pattern_time_long":       "h:mm:ss a z",
+			"pattern_time_medium":     "h:mm:ss a",
+			"pattern_time_short":      "h:mm a",
+			"pm":                      "ከሰዓት",
+			"s":                       "{0}ሴ",
+			"weekday_0":               "እሑድ",
+			"weekday_1":               "ሰኞ",
+			"weekday_2":               "ማክሰኞ",
+			"weekday_3":               "ረቡዕ",
+			"weekday_4":               "ሐሙስ",
+			"weekday_5":               "ዓርብ",
+			"weekday_6":               "ቅዳሜ",
+			"weekday_short_0":         "እሑድ",
+			"weekday_short_1":         "ሰኞ",
+			"weekday_short_2":         "ማክሰ",
+			"weekday_short_3":         "ረቡዕ",
+			"weekday_short_4":         "ሐሙስ",
+			"weekday_short_5":         "ዓርብ",
+			"weekday_short_6":         "ቅዳሜ",
+			"y":                       "{0}ዓ",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} ቀን", PluralOther: "{0} ቀናት"},
+			"hour": {PluralOne: "{0} ሰዓት", PluralOther: "{0} ሰዓት"},
+			"min":  {PluralOne: "{0} ደቂቃ", PluralOther: "{0} ደቂቃ"},
+			"sec":  {PluralOne: "{0} ሰከንድ", PluralOther: "{0} ሰከንድ"},
+			"year": {PluralOne: "{0} ዓመት", PluralOther: "{0} ዓመታት"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "በ{0} ቀን ውስጥ", PluralOther: "በ{0} ቀናት ውስጥ"},
+				ContextPast:   {PluralOne: "ከ{0} ቀን በፊት", PluralOther: "ከ{0} ቀናት በፊት"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "በ{0} ሰዓት ውስጥ", PluralOther: "በ{0} ሰዓት ውስጥ"},
+				ContextPast:   {PluralOne: "ከ{0} ሰዓት በፊት", PluralOther: "ከ{0} ሰዓት በፊት"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "በ{0} ደቂቃ ውስጥ", PluralOther: "በ{0} ደቂቃ ውስጥ"},
+				ContextPast:   {PluralOne: "ከ{0} ደቂቃ በፊት", PluralOther: "ከ{0} ደቂቃ በፊት"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "በ{0} ሰከንድ ውስጥ", PluralOther: "በ{0} ሰከንድ ውስጥ"},
+				ContextPast:   {PluralOne: "ከ{0} ሰከንድ በፊት", PluralOther: "ከ{0} ሰከንድ በፊት"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "በ{0} ዓመት ውስጥ", PluralOther: "በ{0} ዓመታት ውስጥ"},
+				ContextPast:   {PluralOne: "ከ{0} ዓመት በፊት", PluralOther: "ከ{0} ዓመታት በፊት"},
+			},
+		},
+	},
+	{
+		Code: "ar",
+		PluralRules: map[PluralCategory]string{
+			PluralZero: "n = 0",
+			PluralOne:  "n = 1",
+			PluralTwo:  "n = 2",
+			PluralFew:  "n % 100 = 3..10",
+			PluralMany: "n % 100 = 11..99",
+		},
+		Dictionary: map[string]string{
+			"am":                      "ص",
+			"coptic_era_0":            "ق.ش",
+			"coptic_era_1":            "ش",
+			"coptic_month_1":          "توت",
+			"coptic_month_10":         "بؤونة",
+			"coptic_month_11":         "أبيب",
+			"coptic_month_12":         "مسرى",
+			"coptic_month_13":         "نسيئ",
+			"coptic_month_2":          "بابه",
+			"coptic_month_3":          "هاتور",
+			"coptic_month_4":          "كيهك",
+			"coptic_month_5":          "طوبة",
+			"coptic_month_6":          "أمشير",
+			"coptic_month_7":          "برمهات",
+			"coptic_month_8":          "برمودة",
+			"coptic_month_9":          "بشنس",
+			"coptic_month_short_1":    "توت",
+			"coptic_month_short_10":   "بؤونة",
+			"coptic_month_short_11":   "أبيب",
+			"coptic_month_short_12":   "مسرى",
+			"coptic_month_short_13":   "نسيئ",
+			"coptic_month_short_2":    "بابه",
+			"coptic_month_short_3":    "هاتور",
+			"coptic_month_short_4":    "كيهك",
+			"coptic_month_short_5":    "طوبة",
+			"coptic_month_short_6":    "أمشير",
+			"coptic_month_short_7":    "برمهات",
+			"coptic_month_short_8":    "برمودة",
+			"coptic_month_short_9":    "بشنس",
+			"d":                       "{0} ي",
+			"era_0":                   "ق.م",
+			"era_1":                   "م",
+			"h":                       "{0} س",
+			"islamic_era_0":           "هـ",
+			"islamic_month_1":         "محرم",
+			"islamic_month_10":        "شوال",
+			"islamic_month_11":        "ذو القعدة",
+			"islamic_month_12":        "ذو الحجة",
+			"islamic_month_2":         "صفر",
+			"islamic_month_3":         "ربيع الأول",
+			"islamic_month_4":         "ربيع الآخر",
+			"islamic_month_5":         "جمادى الأولى",
+			"islamic_month_6":         "جمادى الآخرة",
+			"islamic_month_7":         "رجب",
+			"islamic_month_8":         "شعبان",
+			"islamic_month_9":         "رمضان",
+			"islamic_month_short_1":   "محرم",
+			"islamic_month_short_10":  "شوال",
+			"islamic_month_short_11":  "ذو القعدة",
+			"islamic_month_short_12":  "ذو الحجة",
+			"islamic_month_short_2":   "صفر",
+			"islamic_month_short_3":   "ربيع الأول",
+			"islamic_month_short_4":   "ربيع الآخر",
+			"islamic_month_short_5":   "جمادى الأولى",
+			"islamic_month_short_6":   "جمادى الآخرة",
+			"islamic_month_short_7":   "رجب",
+			"islamic_month_short_8":   "شعبان",
+			"islamic_month_short_9":   "رمضان",
+			"just_now":                "الآن",
+			"m":                       "{0} د",
+			"month_1":                 "يناير",
+			"month_10":                "أكتوبر",
+			"month_11":                "نوفمبر",
+			"month_12":                "ديسمبر",
+			"month_2":                 "فبراير",
+			"month_3":                 "مارس",
+			"month_4":                 "أبريل",
+			"month_5":                 "مايو",
+			"month_6":                 "يونيو",
+			"month_7":                 "يوليو",
+			"month_8":                 "أغسطس",
+			"month_9":                 "سبتمبر",
+			"month_short_1":           "يناير",
+			"month_short_10":          "أكتوبر",
+			"month_short_11":          "نوفمبر",
+			"month_short_12":          "ديسمبر",
+			"month_short_2":           "فبراير",
+			"month_short_3":           "مارس",
+			"month_short_4":           "أبريل",
+			"month_short_5":           "مايو",
+			"month_short_6":           "يونيو",
+			"month_short_7":           "يوليو",
+			"month_short_8":           "أغسطس",
+			"month_short_9":           "سبتمبر",
+			"month_standalone_1":      "يناير",
+			"month_standalone_10":     "أكتوبر",
+			"month_standalone_11":     "نوفمبر",
+			"month_standalone_12":     "ديسمبر",
+			"month_standalone_2":      "فبراير",
+			"month_standalone_3":      "مارس",
+			"month_standalone_4":      "أبريل",
+			"month_standalone_5":      "مايو",
+			"month_standalone_6":      "يونيو",
+			"month_standalone_7":      "يوليو",
+			"month_standalone_8":      "أغسطس",
+			"month_standalone_9":      "سبتمبر",
+			"pattern_date_full":       "EEEE، d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "dd\u200f/MM\u200f/y",
+			"pattern_date_short":      "d\u200f/M\u200f/y",
+			"pattern_datetime":        "{1}، {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E، d/\u200fM",
+			"pattern_skeleton_MMMEd":  "E، d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/\u200fM",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M\u200f/y",
+			"pattern_skeleton_yMEd":   "E، d/\u200fM/\u200fy",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E، d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d\u200f/M\u200f/y",
+			"pattern_time_full":       "h:mm:ss a zzzz",
+			"pattern_time_long":       "h:mm:ss a z",
+			"pattern_time_medium":     "h:mm:ss a",
+			"pattern_time_short":      "h:mm a",
+			"pm":                      "م",
+			"s":                       "{0} ث",
+			"weekday_0":               "الأحد",
+			"weekday_1":               "الاثنين",
+			"weekday_2":               "الثلاثاء",
+			"weekday_3":               "الأربعاء",
+			"weekday_4":               "الخميس",
+			"weekday_5":               "الجمعة",
+			"weekday_6":               "السبت",
+			"weekday_short_0":         "الأحد",
+			"weekday_short_1":         "الاثنين",
+			"weekday_short_2":         "الثلاثاء",
+			"weekday_short_3":         "الأربعاء",
+			"weekday_short_4":         "الخميس",
+			"weekday_short_5":         "الجمعة",
+			"weekday_short_6":         "السبت",
+			"y":                       "{0} سنة",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralZero: "{0} يوم", PluralFew: "{0} أيام", PluralMany: "{0} يومًا", PluralOther: "{0} يوم"},
+			"hour": {PluralZero: "{0} ساعة", PluralFew: "{0} ساعات", PluralMany: "{0} ساعة", PluralOther: "{0} ساعة"},
+			"min":  {PluralZero: "{0} دقيقة", PluralFew: "{0} دقائق", PluralMany: "{0} دقيقة", PluralOther: "{0} دقيقة"},
+			"sec":  {PluralZero: "{0} ثانية", PluralFew: "{0} ثوانٍ", PluralMany: "{0} ثانية", PluralOther: "{0} ثانية"},
+			"year": {PluralZero: "{0} سنة", PluralFew: "{0} سنوات", PluralMany: "{0} سنة", PluralOther: "{0} سنة"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralZero: "خلال {0} يوم", PluralFew: "خلال {0} أيام", PluralMany: "خلال {0} يومًا", PluralOther: "خلال {0} يوم"},
+				ContextPast:   {PluralZero: "قبل {0} يوم", PluralFew: "قبل {0} أيام", PluralMany: "قبل {0} يومًا", PluralOther: "قبل {0} يوم"},
+			},
+			"hour": {
+				ContextFuture: {PluralZero: "خلال {0} ساعة", PluralFew: "خلال {0} ساعات", PluralMany: "خلال {0} ساعة", PluralOther: "خلال {0} ساعة"},
+				ContextPast:   {PluralZero: "قبل {0} ساعة", PluralFew: "قبل {0} ساعات", PluralMany: "قبل {0} ساعة", PluralOther: "قبل {0} ساعة"},
+			},
+			"min": {
+				ContextFuture: {PluralZero: "خلال {0} دقيقة", PluralFew: "خلال {0} دقائق", PluralMany: "خلال {0} دقيقة", PluralOther: "خلال {0} دقيقة"},
+				ContextPast:   {PluralZero: "قبل {0} دقيقة", PluralFew: "قبل {0} دقائق", PluralMany: "قبل {0} دقيقة", PluralOther: "قبل {0} دقيقة"},
+			},
+			"sec": {
+				ContextFuture: {PluralZero: "خلال {0} ثانية", PluralFew: "خلال {0} ثوانٍ", PluralMany: "خلال {0} ثانية", PluralOther: "خلال {0} ثانية"},
+				ContextPast:   {PluralZero: "قبل {0} ثانية", PluralFew: "قبل {0} ثوانٍ", PluralMany: "قبل {0} ثانية", PluralOther: "قبل {0} ثانية"},
+			},
+			"year": {
+				ContextFuture: {PluralZero: "خلال {0} سنة", PluralFew: "خلال {0} سنوات", PluralMany: "خلال {0} سنة", PluralOther: "خلال {0} سنة"},
+				ContextPast:   {PluralZero: "قبل {0} سنة", PluralFew: "قبل {0} سنوات", PluralMany: "قبل {0} سنة", PluralOther: "قبل {0} سنة"},
+			},
+		},
+	},
+	{
+		Code: "de",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0} T.",
+			"era_0":                   "v. Chr.",
+			"era_1":                   "n. Chr.",
+			"h":                       "{0} Std.",
+			"just_now":                "jetzt",
+			"m":                       "{0} min",
+			"month_1":                 "Januar",
+			"month_10":                "Oktober",
+			"month_11":                "November",
+			"month_12":                "Dezember",
+			"month_2":                 "Februar",
+			"month_3":                 "März",
+			"month_4":                 "April",
+			"month_5":                 "Mai",
+			"month_6":                 "Juni",
+			"month_7":                 "Juli",
+			"month_8":                 "August",
+			"month_9":                 "September",
+			"month_short_1":           "Jan.",
+			"month_short_10":          "Okt.",
+			"month_short_11":          "Nov.",
+			"month_short_12":          "Dez.",
+			"month_short_2":           "Feb.",
+			"month_short_3":           "März",
+			"month_short_4":           "Apr.",
+			"month_short_5":           "Mai",
+			"month_short_6":           "Juni",
+			"month_short_7":           "Juli",
+			"month_short_8":           "Aug.",
+			"month_short_9":           "Sept.",
+			"month_standalone_1":      "Januar",
+			"month_standalone_10":     "Oktober",
+			"month_standalone_11":     "November",
+			"month_standalone_12":     "Dezember",
+			"month_standalone_2":      "Februar",
+			"month_standalone_3":      "März",
+			"month_standalone_4":      "April",
+			"month_standalone_5":      "Mai",
+			"month_standalone_6":      "Juni",
+			"month_standalone_7":      "Juli",
+			"month_standalone_8":      "August",
+			"month_standalone_9":      "September",
+			"pattern_date_full":       "EEEE, d. MMMM y",
+			"pattern_date_long":       "d. MMMM y",
+			"pattern_date_medium":     "dd.MM.y",
+			"pattern_date_short":      "dd.MM.yy",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d. MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, d.M.",
+			"pattern_skeleton_MMMEd":  "E, d. MMM",
+			"pattern_skeleton_MMMMd":  "d. MMMM",
+			"pattern_skeleton_MMMd":   "d. MMM",
+			"pattern_skeleton_Md":     "d.M.",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d.M.y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d. MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d. MMM y",
+			"pattern_skeleton_yMd":    "d.M.y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "PM",
+			"s":                       "{0} s",
+			"weekday_0":               "Sonntag",
+			"weekday_1":               "Montag",
+			"weekday_2":               "Dienstag",
+			"weekday_3":               "Mittwoch",
+			"weekday_4":               "Donnerstag",
+			"weekday_5":               "Freitag",
+			"weekday_6":               "Samstag",
+			"weekday_short_0":         "So.",
+			"weekday_short_1":         "Mo.",
+			"weekday_short_2":         "Di.",
+			"weekday_short_3":         "Mi.",
+			"weekday_short_4":         "Do.",
+			"weekday_short_5":         "Fr.",
+			"weekday_short_6":         "Sa.",
+			"y":                       "{0} J.",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} Tag", PluralOther: "{0} Tage"},
+			"hour": {PluralOne: "{0} Stunde", PluralOther: "{0} Stunden"},
+			"min":  {PluralOne: "{0} Minute", PluralOther: "{0} Minuten"},
+			"sec":  {PluralOne: "{0} Sekunde", PluralOther: "{0} Sekunden"},
+			"year": {PluralOne: "{0} Jahr", PluralOther: "{0} Jahre"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "in {0} Tag", PluralOther: "in {0} Tagen"},
+				ContextPast:   {PluralOne: "vor {0} Tag", PluralOther: "vor {0} Tagen"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "in {0} Stunde", PluralOther: "in {0} Stunden"},
+				ContextPast:   {PluralOne: "vor {0} Stunde", PluralOther: "vor {0} Stunden"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "in {0} Minute", PluralOther: "in {0} Minuten"},
+				ContextPast:   {PluralOne: "vor {0} Minute", PluralOther: "vor {0} Minuten"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "in {0} Sekunde", PluralOther: "in {0} Sekunden"},
+				ContextPast:   {PluralOne: "vor {0} Sekunde", PluralOther: "vor {0} Sekunden"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "in {0} Jahr", PluralOther: "in {0} Jahren"},
+				ContextPast:   {PluralOne: "vor {0} Jahr", PluralOther: "vor {0} Jahren"},
+			},
+		},
+	},
+	{
+		Code: "en",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"am":                         "AM",
+			"buddhist_era_0":             "BE",
+			"chinese_era_0":              "jia-zi",
+			"chinese_era_1":              "yi-chou",
+			"chinese_era_10":             "jia-xu",
+			"chinese_era_11":             "yi-hai",
+			"chinese_era_12":             "bing-zi",
+			"chinese_era_13":             "ding-chou",
+			"chinese_era_14":             "wu-yin",
+			"chinese_era_15":             "ji-mao",
+			"chinese_era_16":             "geng-chen",
+			"chinese_era_17":             "xin-si",
+			"chinese_era_18":             "ren-wu",
+			"chinese_era_19":             "gui-wei",
+			"chinese_era_2":              "bing-yin",
+			"chinese_era_20":             "jia-shen",
+			"chinese_era_21":             "yi-you",
+			"chinese_era_22":             "bing-xu",
+			"chinese_era_23":             "ding-hai",
+			"chinese_era_24":             "wu-zi",
+			"chinese_era_25":             "ji-chou",
+			"chinese_era_26":             "geng-yin",
+			"chinese_era_27":             "xin-mao",
+			"chinese_era_28":             "ren-chen",
+			"chinese_era_29":             "gui-si",
+			"chinese_era_3":              "ding-mao",
+			"chinese_era_30":             "jia-wu",
+			"chinese_era_31":             "yi-wei",
+			"chinese_era_32":             "bing-shen",
+			"chinese_era_33":             "ding-you",
+			"chinese_era_34":             "wu-xu",
+			"chinese_era_35":             "ji-hai",
+			"chinese_era_36":             "geng-zi",
+			"chinese_era_37":             "xin-chou",
+			"chinese_era_38":             "ren-yin",
+			"chinese_era_39":             "gui-mao",
+			"chinese_era_4":              "wu-chen",
+			"chinese_era_40":             "jia-chen",
+			"chinese_era_41":             "yi-si",
+			"chinese_era_42":             "bing-wu",
+			"chinese_era_43":             "ding-wei",
+			"chinese_era_44":             "wu-shen",
+			"chinese_era_45":             "ji-you",
+			"chinese_era_46":             "geng-xu",
+			"chinese_era_47":             "xin-hai",
+			"chinese_era_48":             "ren-zi",
+			"chinese_era_49":             "gui-chou",
+			"chinese_era_5":              "ji-si",
+			"chinese_era_50":             "jia-yin",
+			"chinese_era_51":             "yi-mao",
+			"chinese_era_52":             "bing-chen",
+			"chinese_era_53":             "ding-si",
+			"chinese_era_54":             "wu-wu",
+			"chinese_era_55":             "ji-wei",
+			"chinese_era_56":             "geng-shen",
+			"chinese_era_57":             "xin-you",
+			"chinese_era_58":             "ren-xu",
+			"chinese_era_59":             "gui-hai",
+			"chinese_era_6":              "geng-wu",
+			"chinese_era_7":              "xin-wei",
+			"chinese_era_8":              "ren-shen",
+			"chinese_era_9":              "gui-you",
+			"chinese_month_1":            "First Month",
+			"chinese_month_10":           "Tenth Month",
+			"chinese_month_11":           "Eleventh Month",
+			"chinese_month_12":           "Twelfth Month",
+			"chinese_month_2":            "Second Month",
+			"chinese_month_3":            "Third Month",
+			"chinese_month_4":            "Fourth Month",
+			"chinese_month_5":            "Fifth Month",
+			"chinese_month_6":            "Sixth Month",
+			"chinese_month_7":            "Seventh Month",
+			"chinese_month_8":            "Eighth Month",
+			"chinese_month_9":            "Ninth Month",
+			"chinese_month_pattern_leap": "{0}bis",
+			"chinese_month_short_1":      "Mo1",
+			"chinese_month_short_10":     "Mo10",
+			"chinese_month_short_11":     "Mo11",
+			"chinese_month_short_12":     "Mo12",
+			"chinese_month_short_2":      "Mo2",
+			"chinese_month_short_3":      "Mo3",
+			"chinese_month_short_4":      "Mo4",
+			"chinese_month_short_5":      "Mo5",
+			"chinese_month_short_6":      "Mo6",
+			"chinese_month_short_7":      "Mo7",
+			"chinese_month_short_8":      "Mo8",
+			"chinese_month_short_9":      "Mo9",
+			"chinese_solar_term_1":       "spring begins",
+			"chinese_solar_term_10":      "summer solstice",
+			"chinese_solar_term_11":      "minor heat",
+			"chinese_solar_term_12":      "major heat",
+			"chinese_solar_term_13":      "autumn begins",
+			"chinese_solar_term_14":      "end of heat",
+			"chinese_solar_term_15":      "white dew",
+			"chinese_solar_term_16":      "autumn equinox",
+			"chinese_solar_term_17":      "cold dew",
+			"chinese_solar_term_18":      "frost descends",
+			"chinese_solar_term_19":      "winter begins",
+			"chinese_solar_term_2":       "rain water",
+			"chinese_solar_term_20":      "minor snow",
+			"chinese_solar_term_21":      "major snow",
+			"chinese_solar_term_22":      "winter solstice",
+			"chinese_solar_term_23":      "minor cold",
+			"chinese_solar_term_24":      "major cold",
+			"chinese_solar_term_3":       "insects awaken",
+			"chinese_solar_term_4":       "spring equinox",
+			"chinese_solar_term_5":       "bright and clear",
+			"chinese_solar_term_6":       "grain rain",
+			"chinese_solar_term_7":       "summer begins",
+			"chinese_solar_term_8":       "grain full",
+			"chinese_solar_term_9":       "grain in ear",
+			"chinese_zodiac_1":           "Rat",
+			"chinese_zodiac_10":          "Rooster",
+			"chinese_zodiac_11":          "Dog",
+			"chinese_zodiac_12":          "Pig",
+			"chinese_zodiac_2":           "Ox",
+			"chinese_zodiac_3":           "Tiger",
+			"chinese_zodiac_4":           "Rabbit",
+			"chinese_zodiac_5":           "Dragon",
+			"chinese_zodiac_6":           "Snake",
+			"chinese_zodiac_7":           "Horse",
+			"chinese_zodiac_8":           "Goat",
+			"chinese_zodiac_9":           "Monkey",
+			"coptic_era_0":               "ERA0",
+			"coptic_era_1":               "ERA1",
+			"coptic_month_1":             "Tout",
+			"coptic_month_10":            "Paona",
+			"coptic_month_11":            "Epep",
+			"coptic_month_12":            "Mesra",
+			"coptic_month_13":            "Nasie",
+			"coptic_month_2":             "Baba",
+			"coptic_month_3":             "Hator",
+			"coptic_month_4":             "Kiahk",
+			"coptic_month_5":             "Toba",
+			"coptic_month_6":             "Amshir",
+			"coptic_month_7":             "Baramhat",
+			"coptic_month_8":             "Baramouda",
+			"coptic_month_9":             "Bashans",
+			"coptic_month_short_1":       "Tout",
+			"coptic_month_short_10":      "Paona",
+			"coptic_month_short_11":      "Epep",
+			"coptic_month_short_12":      "Mesra",
+			"coptic_month_short_13":      "Nasie",
+			"coptic_month_short_2":       "Baba",
+			"coptic_month_short_3":       "Hator",
+			"coptic_month_short_4":       "Kiahk",
+			"coptic_month_short_5":       "Toba",
+			"coptic_month_short_6":       "Amshir",
+			"coptic_month_short_7":       "Baramhat",
+			"coptic_month_short_8":       "Baramouda",
+			"coptic_month_short_9":       "Bashans",
+			"d":                          "{0}d",
+			"era_0":                      "BC",
+			"era_1":                      "AD",
+			"ethiopic_era_0":             "ERA0",
+			"ethiopic_era_1":             "ERA1",
+			"ethiopic_month_1":           "Meskerem",
+			"ethiopic_month_10":          "Sene",
+			"ethiopic_month_11":          "Hamle",
+			"ethiopic_month_12":          "Nehasse",
+			"ethiopic_month_13":          "Pagumen",
+			"ethiopic_month_2":           "Tekemt",
+			"ethiopic_month_3":           "Hedar",
+			"ethiopic_month_4":           "Tahsas",
+			"ethiopic_month_5":           "Ter",
+			"ethiopic_month_6":           "Yekatit",
+			"ethiopic_month_7":           "Megabit",
+			"ethiopic_month_8":           "Miazia",
+			"ethiopic_month_9":           "Genbot",
+			"ethiopic_month_short_1":     "Meskerem",
+			"ethiopic_month_short_10":    "Sene",
+			"ethiopic_month_short_11":    "Hamle",
+			"ethiopic_month_short_12":    "Nehasse",
+			"ethiopic_month_short_13":    "Pagumen",
+			"ethiopic_month_short_2":     "Tekemt",
+			"ethiopic_month_short_3":     "Hedar",
+			"ethiopic_month_short_4":     "Tahsas",
+			"ethiopic_month_short_5":     "Ter",
+			"ethiopic_month_short_6":     "Yekatit",
+			"ethiopic_month_short_7":     "Megabit",
+			"ethiopic_month_short_8":     "Miazia",
+			"ethiopic_month_short_9":     "Genbot",
+			"h":                          "{0}h",
+			"hebrew_era_0":               "AM",
+			"hebrew_month_1":             "Tishri",
+			"hebrew_month_10":            "Sivan",
+			"hebrew_month_11":            "Tamuz",
+			"hebrew_month_12":            "Av",
+			"hebrew_month_13":            "Elul",
+			"hebrew_month_2":             "Heshvan",
+			"hebrew_month_3":             "Kislev",
+			"hebrew_month_4":             "Tevet",
+			"hebrew_month_5":             "Shevat",
+			"hebrew_month_6":             "Adar I",
+			"hebrew_month_7":             "Adar",
+			"hebrew_month_8":             "Nisan",
+			"hebrew_month_9":             "Iyar",
+			"hebrew_month_leap_7":        "Adar II",
+			"hebrew_month_short_1":       "Tishri",
+			"hebrew_month_short_10":      "Sivan",
+			"hebrew_month_short_11":      "Tamuz",
+			"hebrew_month_short_12":      "Av",
+			"hebrew_month_short_13":      "Elul",
+			"hebrew_month_short_2":       "Heshvan",
+			"hebrew_month_short_3":       "Kislev",
+			"hebrew_month_short_4":       "Tevet",
+			"hebrew_month_short_5":       "Shevat",
+			"hebrew_month_short_6":       "Adar I",
+			"hebrew_month_short_7":       "Adar",
+			"hebrew_month_short_8":       "Nisan",
+			"hebrew_month_short_9":       "Iyar",
+			"hebrew_month_short_leap_7":  "Adar II",
+			"indian_era_0":               "Saka",
+			"indian_month_1":             "Chaitra",
+			"indian_month_10":            "Pausa",
+			"indian_month_11":            "Magha",
+			"indian_month_12":            "Phalguna",
+			"indian_month_2":             "Vaisakha",
+			"indian_month_3":             "Jyaistha",
+			"indian_month_4":             "Asadha",
+			"indian_month_5":             "Sravana",
+			"indian_month_6":             "Bhadra",
+			"indian_month_7":             "Asvina",
+			"indian_month_8":             "Kartika",
+			"indian_month_9":             "Agrahayana",
+			"indian_month_short_1":       "Chaitra",
+			"indian_month_short_10":      "Pausa",
+			"indian_month_short_11":      "Magha",
+			"indian_month_short_12":      "Phalguna",
+			"indian_month_short_2":       "Vaisakha",
+			"indian_month_short_3":       "Jyaistha",
+			"indian_month_short_4":       "Asadha",
+			"indian_month_short_5":       "Sravana",
+			"indian_month_short_6":       "Bhadra",
+			"indian_month_short_7":       "Asvina",
+			"indian_month_short_8":       "Kartika",
+			"indian_month_short_9":       "Agrahayana",
+			"islamic_era_0":              "AH",
+			"islamic_month_1":            "Muharram",
+			"islamic_month_10":           "Shawwal",
+			"islamic_month_11":           "Dhuʻl-Qiʻdah",
+			"islamic_month_12":           "Dhuʻl-Hijjah",
+			"islamic_month_2":            "Safar",
+			"islamic_month_3":            "Rabiʻ I",
+			"islamic_month_4":            "Rabiʻ II",
+			"islamic_month_5":            "Jumada I",
+			"islamic_month_6":            "Jumada II",
+			"islamic_month_7":            "Rajab",
+			"islamic_month_8":            "Shaʻban",
+			"islamic_month_9":            "Ramadan",
+			"islamic_month_short_1":      "Muh.",
+			"islamic_month_short_10":     "Shaw.",
+			"islamic_month_short_11":     "Dhuʻl-Q.",
+			"islamic_month_short_12":     "Dhuʻl-H.",
+			"islamic_month_short_2":      "Saf.",
+			"islamic_month_short_3":      "Rab. I",
+			"islamic_month_short_4":      "Rab. II",
+			"islamic_month_short_5":      "Jum. I",
+			"islamic_month_short_6":      "Jum. II",
+			"islamic_month_short_7":      "Raj.",
+			"islamic_month_short_8":      "Sha.",
+			"islamic_month_short_9":      "Ram.",
+			"japanese_era_232":           "Meiji",
+			"japanese_era_233":           "Taishō",
+			"japanese_era_234":           "Shōwa",
+			"japanese_era_235":           "Heisei",
+			"japanese_era_236":           "Reiwa",
+			"japanese_era_narrow_232":    "M",
+			"japanese_era_narrow_233":    "T",
+			"japanese_era_narrow_234":    "S",
+			"japanese_era_narrow_235":    "H",
+			"japanese_era_narrow_236":    "R",
+			"just_now":                   "now",
+			"m":                          "{0}m",
+			"month_1":                    "January",
+			"month_10":                   "October",
+			"month_11":                   "November",
+			"month_12":                   "December",
+			"month_2":                    "February",
+			"month_3":                    "March",
+			"month_4":                    "April",
+			"month_5":                    "May",
+			"month_6":                    "June",
+			"month_7":                    "July",
+			"month_8":                    "August",
+			"month_9":                    "September",
+			"month_short_1":              "Jan",
+			"month_short_10":             "Oct",
+			"month_short_11":             "Nov",
+			"month_short_12":             "Dec",
+			"month_short_2":              "Feb",
+			"month_short_3":              "Mar",
+			"month_short_4":              "Apr",
+			"month_short_5":              "May",
+			"month_short_6":              "Jun",
+			"month_short_7":              "Jul",
+			"month_short_8":              "Aug",
+			"month_short_9":              "Sep",
+			"month_standalone_1":         "January",
+			"month_standalone_10":        "October",
+			"month_standalone_11":        "November",
+			"month_standalone_12":        "December",
+			"month_standalone_2":         "February",
+			"month_standalone_3":         "March",
+			"month_standalone_4":         "April",
+			"month_standalone_5":         "May",
+			"month_standalone_6":         "June",
+			"month_standalone_7":         "July",
+			"month_standalone_8":         "August",
+			"month_standalone_9":         "September",
+			"pattern_date_full":          "EEEE, MMMM d, y",
+			"pattern_date_long":          "MMMM d, y",
+			"pattern_date_medium":        "MMM d, y",
+			"pattern_date_short":         "M/d/yy",
+			"pattern_datetime":           "{1}, {0}",
+			"pattern_skeleton_GyMMMd":    "MMM d, y G",
+			"pattern_skeleton_Hm":        "HH:mm",
+			"pattern_skeleton_Hms":       "HH:mm:ss",
+			"pattern_skeleton_MEd":       "E, M/d",
+			"pattern_skeleton_MMMEd":     "E, MMM d",
+			"pattern_skeleton_MMMMd":     "MMMM d",
+			"pattern_skeleton_MMMd":      "MMM d",
+			"pattern_skeleton_Md":        "M/d",
+			"pattern_skeleton_hm":        "h:mm a",
+			"pattern_skeleton_hms":       "h:mm:ss a",
+			"pattern_skeleton_yM":        "M/y",
+			"pattern_skeleton_yMEd":      "E, M/d/y",
+			"pattern_skeleton_yMMM":      "MMM y",
+			"pattern_skeleton_yMMMEd":    "E, MMM d, y",
+			"pattern_skeleton_yMMMM":     "MMMM y",
+			"pattern_skeleton_yMMMd":     "MMM d, y",
+			"pattern_skeleton_yMd":       "M/d/y",
+			"pattern_time_full":          "h:mm:ss a zzzz",
+			"pattern_time_long":          "h:mm:ss a z",
+			"pattern_time_medium":        "h:mm:ss a",
+			"pattern_time_short":         "h:mm a",
+			"persian_era_0":              "AP",
+			"persian_month_1":            "Farvardin",
+			"persian_month_10":           "Dey",
+			"persian_month_11":           "Bahman",
+			"persian_month_12":           "Esfand",
+			"persian_month_2":            "Ordibehesht",
+			"persian_month_3":            "Khordad",
+			"persian_month_4":            "Tir",
+			"persian_month_5":            "Mordad",
+			"persian_month_6":            "Shahrivar",
+			"persian_month_7":            "Mehr",
+			"persian_month_8":            "Aban",
+			"persian_month_9":            "Azar",
+			"persian_month_short_1":      "Farvardin",
+			"persian_month_short_10":     "Dey",
+			"persian_month_short_11":     "Bahman",
+			"persian_month_short_12":     "Esfand",
+			"persian_month_short_2":      "Ordibehesht",
+			"persian_month_short_3":      "Khordad",
+			"persian_month_short_4":      "Tir",
+			"persian_month_short_5":      "Mordad",
+			"persian_month_short_6":      "Shahrivar",
+			"persian_month_short_7":      "Mehr",
+			"persian_month_short_8":      "Aban",
+			"persian_month_short_9":      "Azar",
+			"pm":                         "PM",
+			"roc_era_0":                  "Before R.O.C.",
+			"roc_era_1":                  "Minguo",
+			"s":                          "{0}s",
+			"weekday_0":                  "Sunday",
+			"weekday_1":                  "Monday",
+			"weekday_2":                  "Tuesday",
+			"weekday_3":                  "Wednesday",
+			"weekday_4":                  "Thursday",
+			"weekday_5":                  "Friday",
+			"weekday_6":                  "Saturday",
+			"weekday_short_0":            "Sun",
+			"weekday_short_1":            "Mon",
+			"weekday_short_2":            "Tue",
+			"weekday_short_3":            "Wed",
+			"weekday_short_4":            "Thu",
+			"weekday_short_5":            "Fri",
+			"weekday_short_6":            "Sat",
+			"y":                          "{0}y",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} day", PluralOther: "{0} days"},
+			"hour": {PluralOne: "{0} hour", PluralOther: "{0} hours"},
+			"min":  {PluralOne: "{0} minute", PluralOther: "{0} minutes"},
+			"sec":  {PluralOne: "{0} second", PluralOther: "{0} seconds"},
+			"year": {PluralOne: "{0} year", PluralOther: "{0} years"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "in {0} day", PluralOther: "in {0} days"},
+				ContextPast:   {PluralOne: "{0} day ago", PluralOther: "{0} days ago"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "in {0} hour", PluralOther: "in {0} hours"},
+				ContextPast:   {PluralOne: "{0} hour ago", PluralOther: "{0} hours ago"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "in {0} minute", PluralOther: "in {0} minutes"},
+				ContextPast:   {PluralOne: "{0} minute ago", PluralOther: "{0} minutes ago"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "in {0} second", PluralOther: "in {0} seconds"},
+				ContextPast:   {PluralOne: "{0} second ago", PluralOther: "{0} seconds ago"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "in {0} year", PluralOther: "in {0} years"},
+				ContextPast:   {PluralOne: "{0} year ago", PluralOther: "{0} years ago"},
+			},
+		},
+	},
+	{
+		Code: "en-150",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE, d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E dd/MM",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "dd/MM",
+			"pattern_skeleton_yM":     "MM/y",
+			"pattern_skeleton_yMEd":   "E, dd/MM/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "dd/MM/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+		},
+	},
+	{
+		Code: "en-AU",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/M/yy",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, dd/MM/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "dd/MM/y",
+		},
+	},
+	{
+		Code: "en-CA",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":     "EEEE, MMMM d, y",
+			"pattern_date_long":     "MMMM d, y",
+			"pattern_date_medium":   "MMM d, y",
+			"pattern_date_short":    "y-MM-dd",
+			"pattern_skeleton_MEd":  "E, MM-dd",
+			"pattern_skeleton_Md":   "MM-dd",
+			"pattern_skeleton_yM":   "y-MM",
+			"pattern_skeleton_yMEd": "E, y-MM-dd",
+			"pattern_skeleton_yMd":  "y-MM-dd",
+		},
+	},
+	{
+		Code: "en-GB",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E dd/MM",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "dd/MM",
+			"pattern_skeleton_yM":     "MM/y",
+			"pattern_skeleton_yMEd":   "E, dd/MM/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "dd/MM/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+		},
+	},
+	{
+		Code: "en-IN",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE, d MMMM, y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/yy",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM, y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+		},
+	},
+	{
+		Code: "en-NZ",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE, d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d/MM/y",
+			"pattern_date_short":      "d/MM/yy",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/MM/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/MM/y",
+		},
+	},
+	{
+		Code: "en-SG",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":       "EEEE, d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/M/yy",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+		},
+	},
+	{
+		Code: "es",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "n = 1",
+			PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+		},
+		Dictionary: map[string]string{
+			"am":                      "a. m.",
+			"d":                       "{0}d",
+			"era_0":                   "a. C.",
+			"era_1":                   "d. C.",
+			"h":                       "{0}h",
+			"just_now":                "ahora",
+			"m":                       "{0}min",
+			"month_1":                 "enero",
+			"month_10":                "octubre",
+			"month_11":                "noviembre",
+			"month_12":                "diciembre",
+			"month_2":                 "febrero",
+			"month_3":                 "marzo",
+			"month_4":                 "abril",
+			"month_5":                 "mayo",
+			"month_6":                 "junio",
+			"month_7":                 "julio",
+			"month_8":                 "agosto",
+			"month_9":                 "septiembre",
+			"month_short_1":           "ene",
+			"month_short_10":          "oct",
+			"month_short_11":          "nov",
+			"month_short_12":          "dic",
+			"month_short_2":           "feb",
+			"month_short_3":           "mar",
+			"month_short_4":           "abr",
+			"month_short_5":           "may",
+			"month_short_6":           "jun",
+			"month_short_7":           "jul",
+			"month_short_8":           "ago",
+			"month_short_9":           "sept",
+			"month_standalone_1":      "enero",
+			"month_standalone_10":     "octubre",
+			"month_standalone_11":     "noviembre",
+			"month_standalone_12":     "diciembre",
+			"month_standalone_2":      "febrero",
+			"month_standalone_3":      "marzo",
+			"month_standalone_4":      "abril",
+			"month_standalone_5":      "mayo",
+			"month_standalone_6":      "junio",
+			"month_standalone_7":      "julio",
+			"month_standalone_8":      "agosto",
+			"month_standalone_9":      "septiembre",
+			"pattern_date_full":       "EEEE, d 'de' MMMM 'de' y",
+			"pattern_date_long":       "d 'de' MMMM 'de' y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/M/yy",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "H:mm",
+			"pattern_skeleton_Hms":    "H:mm:ss",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E, d MMM",
+			"pattern_skeleton_MMMMd":  "d 'de' MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "EEE, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "EEE, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM 'de' y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "H:mm:ss (zzzz)",
+			"pattern_time_long":       "H:mm:ss z",
+			"pattern_time_medium":     "H:mm:ss",
+			"pattern_time_short":      "H:mm",
+			"pm":                      "p. m.",
+			"s":                       "{0}s",
+			"weekday_0":               "domingo",
+			"weekday_1":               "lunes",
+			"weekday_2":               "martes",
+			"weekday_3":               "miércoles",
+			"weekday_4":               "jueves",
+			"weekday_5":               "viernes",
+			"weekday_6":               "sábado",
+			"weekday_short_0":         "dom",
+			"weekday_short_1":         "lun",
+			"weekday_short_2":         "mar",
+			"weekday_short_3":         "mié",
+			"weekday_short_4":         "jue",
+			"weekday_short_5":         "vie",
+			"weekday_short_6":         "sáb",
+			"y":                       "{0}a",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} día", PluralMany: "{0} días", PluralOther: "{0} días"},
+			"hour": {PluralOne: "{0} hora", PluralMany: "{0} horas", PluralOther: "{0} horas"},
+			"min":  {PluralOne: "{0} minuto", PluralMany: "{0} minutos", PluralOther: "{0} minutos"},
+			"sec":  {PluralOne: "{0} segundo", PluralMany: "{0} segundos", PluralOther: "{0} segundos"},
+			"year": {PluralOne: "{0} año", PluralMany: "{0} años", PluralOther: "{0} años"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "dentro de {0} día", PluralMany: "dentro de {0} días", PluralOther: "dentro de {0} días"},
+				ContextPast:   {PluralOne: "hace {0} día", PluralMany: "hace {0} días", PluralOther: "hace {0} días"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "dentro de {0} hora", PluralMany: "dentro de {0} horas", PluralOther: "dentro de {0} horas"},
+				ContextPast:   {PluralOne: "hace {0} hora", PluralMany: "hace {0} horas", PluralOther: "hace {0} horas"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "dentro de {0} minuto", PluralMany: "dentro de {0} minutos", PluralOther: "dentro de {0} minutos"},
+				ContextPast:   {PluralOne: "hace {0} minuto", PluralMany: "hace {0} minutos", PluralOther: "hace {0} minutos"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "dentro de {0} segundo", PluralMany: "dentro de {0} segundos", PluralOther: "dentro de {0} segundos"},
+				ContextPast:   {PluralOne: "hace {0} segundo", PluralMany: "hace {0} segundos", PluralOther: "hace {0} segundos"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "dentro de {0} año", PluralMany: "dentro de {0} años", PluralOther: "dentro de {0} años"},
+				ContextPast:   {PluralOne: "hace {0} año", PluralMany: "hace {0} años", PluralOther: "hace {0} años"},
+			},
+		},
+	},
+	{
+		Code: "es-MX",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "n = 1",
+			PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+		},
+		Dictionary: map[string]string{
+			"pattern_date_full":     "EEEE, d 'de' MMMM 'de' y",
+			"pattern_date_long":     "d 'de' MMMM 'de' y",
+			"pattern_date_medium":   "d MMM y",
+			"pattern_date_short":    "dd/MM/yy",
+			"pattern_skeleton_MEd":  "E, d/M",
+			"pattern_skeleton_Md":   "d/M",
+			"pattern_skeleton_yM":   "M/y",
+			"pattern_skeleton_yMEd": "E, d/M/y",
+			"pattern_skeleton_yMd":  "d/M/y",
+			"pattern_time_full":     "H:mm:ss zzzz",
+			"pattern_time_long":     "H:mm:ss z",
+			"pattern_time_medium":   "H:mm:ss",
+			"pattern_time_short":    "H:mm",
+		},
+	},
+	{
+		Code: "fa",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 0 or n = 1",
+		},
+		Dictionary: map[string]string{
+			"am":                      "ق.ظ.",
+			"d":                       "{0}ر",
+			"digits":                  "۰۱۲۳۴۵۶۷۸۹",
+			"era_0":                   "ق.م.",
+			"era_1":                   "م.",
+			"h":                       "{0}س",
+			"just_now":                "اکنون",
+			"m":                       "{0}د",
+			"month_1":                 "ژانویه",
+			"month_10":                "اکتبر",
+			"month_11":                "نوامبر",
+			"month_12":                "دسامبر",
+			"month_2":                 "فوریه",
+			"month_3":                 "مارس",
+			"month_4":                 "آوریل",
+			"month_5":                 "مه",
+			"month_6":                 "ژوئن",
+			"month_7":                 "ژوئیه",
+			"month_8":                 "اوت",
+			"month_9":                 "سپتامبر",
+			"month_short_1":           "ژانویه",
+			"month_short_10":          "اکتبر",
+			"month_short_11":          "نوامبر",
+			"month_short_12":          "دسامبر",
+			"month_short_2":           "فوریه",
+			"month_short_3":           "مارس",
+			"month_short_4":           "آوریل",
+			"month_short_5":           "مه",
+			"month_short_6":           "ژوئن",
+			"month_short_7":           "ژوئیه",
+			"month_short_8":           "اوت",
+			"month_short_9":           "سپتامبر",
+			"month_standalone_1":      "ژانویه",
+			"month_standalone_10":     "اکتبر",
+			"month_standalone_11":     "نوامبر",
+			"month_standalone_12":     "دسامبر",
+			"month_standalone_2":      "فوریه",
+			"month_standalone_3":      "مارس",
+			"month_standalone_4":      "آوریل",
+			"month_standalone_5":      "مه",
+			"month_standalone_6":      "ژوئن",
+			"month_standalone_7":      "ژوئیه",
+			"month_standalone_8":      "اوت",
+			"month_standalone_9":      "سپتامبر",
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "y/M/d",
+			"pattern_datetime":        "{1}،\u200f {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "H:mm",
+			"pattern_skeleton_Hms":    "H:mm:ss",
+			"pattern_skeleton_MEd":    "E M/d",
+			"pattern_skeleton_MMMEd":  "E d LLL",
+			"pattern_skeleton_MMMMd":  "d LLLL",
+			"pattern_skeleton_MMMd":   "d LLL",
+			"pattern_skeleton_Md":     "M/d",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "y/M",
+			"pattern_skeleton_yMEd":   "E y/M/d",
+			"pattern_skeleton_yMMM":   "LLL y",
+			"pattern_skeleton_yMMMEd": "E d MMM y",
+			"pattern_skeleton_yMMMM":  "LLLL y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "y/M/d",
+			"pattern_time_full":       "H:mm:ss (zzzz)",
+			"pattern_time_long":       "H:mm:ss (z)",
+			"pattern_time_medium":     "H:mm:ss",
+			"pattern_time_short":      "H:mm",
+			"persian_era_0":           "ه\u200d.ش.",
+			"persian_month_1":         "فروردین",
+			"persian_month_10":        "دی",
+			"persian_month_11":        "بهمن",
+			"persian_month_12":        "اسفند",
+			"persian_month_2":         "اردیبهشت",
+			"persian_month_3":         "خرداد",
+			"persian_month_4":         "تیر",
+			"persian_month_5":         "مرداد",
+			"persian_month_6":         "شهریور",
+			"persian_month_7":         "مهر",
+			"persian_month_8":         "آبان",
+			"persian_month_9":         "آذر",
+			"persian_month_short_1":   "فروردین",
+			"persian_month_short_10":  "دی",
+			"persian_month_short_11":  "بهمن",
+			"persian_month_short_12":  "اسفند",
+			"persian_month_short_2":   "اردیبهشت",
+			"persian_month_short_3":   "خرداد",
+			"persian_month_short_4":   "تیر",
+			"persian_month_short_5":   "مرداد",
+			"persian_month_short_6":   "شهریور",
+			"persian_month_short_7":   "مهر",
+			"persian_month_short_8":   "آبان",
+			"persian_month_short_9":   "آذر",
+			"pm":                      "ب.ظ.",
+			"s":                       "{0}ث",
+			"weekday_0":               "یکشنبه",
+			"weekday_1":               "دوشنبه",
+			"weekday_2":               "سه\u200cشنبه",
+			"weekday_3":               "چهارشنبه",
+			"weekday_4":               "پنجشنبه",
+			"weekday_5":               "جمعه",
+			"weekday_6":               "شنبه",
+			"weekday_short_0":         "یکشنبه",
+			"weekday_short_1":         "دوشنبه",
+			"weekday_short_2":         "سه\u200cشنبه",
+			"weekday_short_3":         "چهارشنبه",
+			"weekday_short_4":         "پنجشنبه",
+			"weekday_short_5":         "جمعه",
+			"weekday_short_6":         "شنبه",
+			"y":                       "{0}س",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} روز", PluralOther: "{0} روز"},
+			"hour": {PluralOne: "{0} ساعت", PluralOther: "{0} ساعت"},
+			"min":  {PluralOne: "{0} دقیقه", PluralOther: "{0} دقیقه"},
+			"sec":  {PluralOne: "{0} ثانیه", PluralOther: "{0} ثانیه"},
+			"year": {PluralOne: "{0} سال", PluralOther: "{0} سال"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "{0} روز بعد", PluralOther: "{0} روز بعد"},
+				ContextPast:   {PluralOne: "{0} روز پیش", PluralOther: "{0} روز پیش"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "{0} ساعت بعد", PluralOther: "{0} ساعت بعد"},
+				ContextPast:   {PluralOne: "{0} ساعت پیش", PluralOther: "{0} ساعت پیش"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "{0} دقیقه بعد", PluralOther: "{0} دقیقه بعد"},
+				ContextPast:   {PluralOne: "{0} دقیقه پیش", PluralOther: "{0} دقیقه پیش"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "{0} ثانیه بعد", PluralOther: "{0} ثانیه بعد"},
+				ContextPast:   {PluralOne: "{0} ثانیه پیش", PluralOther: "{0} ثانیه پیش"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "{0} سال بعد", PluralOther: "{0} سال بعد"},
+				ContextPast:   {PluralOne: "{0} سال پیش", PluralOther: "{0} سال پیش"},
+			},
+		},
+	},
+	{
+		Code: "fa-AF",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 0 or n = 1",
+		},
+		Dictionary: map[string]string{
+			"persian_era_0":          "ه\u200d.ش.",
+			"persian_month_1":        "حمل",
+			"persian_month_10":       "جدی",
+			"persian_month_11":       "دلو",
+			"persian_month_12":       "حوت",
+			"persian_month_2":        "ثور",
+			"persian_month_3":        "جوزا",
+			"persian_month_4":        "سرطان",
+			"persian_month_5":        "اسد",
+			"persian_month_6":        "سنبله",
+			"persian_month_7":        "میزان",
+			"persian_month_8":        "عقرب",
+			"persian_month_9":        "قوس",
+			"persian_month_short_1":  "حمل",
+			"persian_month_short_10": "جدی",
+			"persian_month_short_11": "دلو",
+			"persian_month_short_12": "حوت",
+			"persian_month_short_2":  "ثور",
+			"persian_month_short_3":  "جوزا",
+			"persian_month_short_4":  "سرطان",
+			"persian_month_short_5":  "اسد",
+			"persian_month_short_6":  "سنبله",
+			"persian_month_short_7":  "میزان",
+			"persian_month_short_8":  "عقرب",
+			"persian_month_short_9":  "قوس",
+		},
+	},
+	{
+		Code: "fr",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "i = 0,1",
+			PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+		},
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0}j",
+			"era_0":                   "av. J.-C.",
+			"era_1":                   "ap. J.-C.",
+			"h":                       "{0}h",
+			"just_now":                "maintenant",
+			"m":                       "{0}min",
+			"month_1":                 "janvier",
+			"month_10":                "octobre",
+			"month_11":                "novembre",
+			"month_12":                "décembre",
+			"month_2":                 "février",
+			"month_3":                 "mars",
+			"month_4":                 "avril",
+			"month_5":                 "mai",
+			"month_6":                 "juin",
+			"month_7":                 "juillet",
+			"month_8":                 "août",
+			"month_9":                 "septembre",
+			"month_short_1":           "janv.",
+			"month_short_10":          "oct.",
+			"month_short_11":          "nov.",
+			"month_short_12":          "déc.",
+			"month_short_2":           "févr.",
+			"month_short_3":           "mars",
+			"month_short_4":           "avr.",
+			"month_short_5":           "mai",
+			"month_short_6":           "juin",
+			"month_short_7":           "juil.",
+			"month_short_8":           "août",
+			"month_short_9":           "sept.",
+			"month_standalone_1":      "janvier",
+			"month_standalone_10":     "octobre",
+			"month_standalone_11":     "novembre",
+			"month_standalone_12":     "décembre",
+			"month_standalone_2":      "février",
+			"month_standalone_3":      "mars",
+			"month_standalone_4":      "avril",
+			"month_standalone_5":      "mai",
+			"month_standalone_6":      "juin",
+			"month_standalone_7":      "juillet",
+			"month_standalone_8":      "août",
+			"month_standalone_9":      "septembre",
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E dd/MM",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "dd/MM",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "MM/y",
+			"pattern_skeleton_yMEd":   "E dd/MM/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "dd/MM/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "PM",
+			"s":                       "{0}s",
+			"weekday_0":               "dimanche",
+			"weekday_1":               "lundi",
+			"weekday_2":               "mardi",
+			"weekday_3":               "mercredi",
+			"weekday_4":               "jeudi",
+			"weekday_5":               "vendredi",
+			"weekday_6":               "samedi",
+			"weekday_short_0":         "dim.",
+			"weekday_short_1":         "lun.",
+			"weekday_short_2":         "mar.",
+			"weekday_short_3":         "mer.",
+			"weekday_short_4":         "jeu.",
+			"weekday_short_5":         "ven.",
+			"weekday_short_6":         "sam.",
+			"y":                       "{0}a",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} jour", PluralMany: "{0} jours", PluralOther: "{0} jours"},
+			"hour": {PluralOne: "{0} heure", PluralMany: "{0} heures", PluralOther: "{0} heures"},
+			"min":  {PluralOne: "{0} minute", PluralMany: "{0} minutes", PluralOther: "{0} minutes"},
+			"sec":  {PluralOne: "{0} seconde", PluralMany: "{0} secondes", PluralOther: "{0} secondes"},
+			"year": {PluralOne: "{0} an", PluralMany: "{0} ans", PluralOther: "{0} ans"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "dans {0} jour", PluralMany: "dans {0} jours", PluralOther: "dans {0} jours"},
+				ContextPast:   {PluralOne: "il y a {0} jour", PluralMany: "il y a {0} jours", PluralOther: "il y a {0} jours"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "dans {0} heure", PluralMany: "dans {0} heures", PluralOther: "dans {0} heures"},
+				ContextPast:   {PluralOne: "il y a {0} heure", PluralMany: "il y a {0} heures", PluralOther: "il y a {0} heures"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "dans {0} minute", PluralMany: "dans {0} minutes", PluralOther: "dans {0} minutes"},
+				ContextPast:   {PluralOne: "il y a {0} minute", PluralMany: "il y a {0} minutes", PluralOther: "il y a {0} minutes"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "dans {0} seconde", PluralMany: "dans {0} secondes", PluralOther: "dans {0} secondes"},
+				ContextPast:   {PluralOne: "il y a {0} seconde", PluralMany: "il y a {0} secondes", PluralOther: "il y a {0} secondes"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "dans {0} an", PluralMany: "dans {0} ans", PluralOther: "dans {0} ans"},
+				ContextPast:   {PluralOne: "il y a {0} an", PluralMany: "il y a {0} ans", PluralOther: "il y a {0} ans"},
+			},
+		},
+	},
+	{
+		Code: "he",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0 or i = 0 and v != 0",
+			PluralTwo: "i = 2 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"am":                        "לפנה״צ",
+			"d":                         "{0} ימ׳",
+			"era_0":                     "לפנה״ס",
+			"era_1":                     "לספירה",
+			"h":                         "{0} שע׳",
+			"hebrew_era_0":              "לבריאת העולם",
+			"hebrew_month_1":            "תשרי",
+			"hebrew_month_10":           "סיוון",
+			"hebrew_month_11":           "תמוז",
+			"hebrew_month_12":           "אב",
+			"hebrew_month_13":           "אלול",
+			"hebrew_month_2":            "חשוון",
+			"hebrew_month_3":            "כסלו",
+			"hebrew_month_4":            "טבת",
+			"hebrew_month_5":            "שבט",
+			"hebrew_month_6":            "אדר א׳",
+			"hebrew_month_7":            "אדר",
+			"hebrew_month_8":            "ניסן",
+			"hebrew_month_9":            "אייר",
+			"hebrew_month_leap_7":       "אדר ב׳",
+			"hebrew_month_short_1":      "תשרי",
+			"hebrew_month_short_10":     "סיוון",
+			"hebrew_month_short_11":     "תמוז",
+			"hebrew_month_short_12":     "אב",
+			"hebrew_month_short_13":     "אלול",
+			"hebrew_month_short_2":      "חשוון",
+			"hebrew_month_short_3":      "כסלו",
+			"hebrew_month_short_4":      "טבת",
+			"hebrew_month_short_5":      "שבט",
+			"hebrew_month_short_6":      "אדר א׳",
+			"hebrew_month_short_7":      "אדר",
+			"hebrew_month_short_8":      "ניסן",
+			"hebrew_month_short_9":      "אייר",
+			"hebrew_month_short_leap_7": "אדר ב׳",
+			"just_now":                  "עכשיו",
+			"m":                         "{0} דק׳",
+			"month_1":                   "ינואר",
+			"month_10":                  "אוקטובר",
+			"month_11":                  "נובמבר",
+			"month_12":                  "דצמבר",
+			"month_2":                   "פברואר",
+			"month_3":                   "מרץ",
+			"month_4":                   "אפריל",
+			"month_5":                   "מאי",
+			"month_6":                   "יוני",
+			"month_7":                   "יולי",
+			"month_8":                   "אוגוסט",
+			"month_9":                   "ספטמבר",
+			"month_short_1":             "ינו׳",
+			"month_short_10":            "אוק׳",
+			"month_short_11":            "נוב׳",
+			"month_short_12":            "דצמ׳",
+			"month_short_2":             "פבר׳",
+			"month_short_3":             "מרץ",
+			"month_short_4":             "אפר׳",
+			"month_short_5":             "מאי",
+			"month_short_6":             "יוני",
+			"month_short_7":             "יולי",
+			"month_short_8":             "אוג׳",
+			"month_short_9":             "ספט׳",
+			"month_standalone_1":        "ינואר",
+			"month_standalone_10":       "אוקטובר",
+			"month_standalone_11":       "נובמבר",
+			"month_standalone_12":       "דצמבר",
+			"month_standalone_2":        "פברואר",
+			"month_standalone_3":        "מרץ",
+			"month_standalone_4":        "אפריל",
+			"month_standalone_5":        "מאי",
+			"month_standalone_6":        "יוני",
+			"month_standalone_7":        "יולי",
+			"month_standalone_8":        "אוגוסט",
+			"month_standalone_9":        "ספטמבר",
+			"pattern_date_full":         "EEEE, d בMMMM y",
+			"pattern_date_long":         "d בMMMM y",
+			"pattern_date_medium":       "d בMMM y",
+			"pattern_date_short":        "d.M.y",
+			"pattern_datetime":          "{1}, {0}",
+			"pattern_skeleton_GyMMMd":   "d בMMM y G",
+			"pattern_skeleton_Hm":       "H:mm",
+			"pattern_skeleton_Hms":      "H:mm:ss",
+			"pattern_skeleton_MEd":      "E d.M",
+			"pattern_skeleton_MMMEd":    "E, d בMMM",
+			"pattern_skeleton_MMMMd":    "d בMMMM",
+			"pattern_skeleton_MMMd":     "d בMMM",
+			"pattern_skeleton_Md":       "d.M",
+			"pattern_skeleton_hm":       "h:mm a",
+			"pattern_skeleton_hms":      "h:mm:ss a",
+			"pattern_skeleton_yM":       "M.y",
+			"pattern_skeleton_yMEd":     "E, d.M.y",
+			"pattern_skeleton_yMMM":     "MMM y",
+			"pattern_skeleton_yMMMEd":   "E, d בMMM y",
+			"pattern_skeleton_yMMMM":    "MMMM y",
+			"pattern_skeleton_yMMMd":    "d בMMM y",
+			"pattern_skeleton_yMd":      "d.M.y",
+			"pattern_time_full":         "H:mm:ss zzzz",
+			"pattern_time_long":         "H:mm:ss z",
+			"pattern_time_medium":       "H:mm:ss",
+			"pattern_time_short":        "H:mm",
+			"pm":                        "אחה״צ",
+			"s":                         "{0} שנ׳",
+			"weekday_0":                 "יום ראשון",
+			"weekday_1":                 "יום שני",
+			"weekday_2":                 "יום שלישי",
+			"weekday_3":                 "יום רביעי",
+			"weekday_4":                 "יום חמישי",
+			"weekday_5":                 "יום שישי",
+			"weekday_6":                 "יום שבת",
+			"weekday_short_0":           "יום א׳",
+			"weekday_short_1":           "יום ב׳",
+			"weekday_short_2":           "יום ג׳",
+			"weekday_short_3":           "יום ד׳",
+			"weekday_short_4":           "יום ה׳",
+			"weekday_short_5":           "יום ו׳",
+			"weekday_short_6":           "שבת",
+			"y":                         "{0} שנ׳",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "יום {0}", PluralOther: "{0} ימים"},
+			"hour": {PluralOther: "{0} שעות"},
+			"min":  {PluralTwo: "{0} דקות", PluralOther: "{0} דקות"},
+			"sec":  {PluralTwo: "{0} שניות", PluralOther: "{0} שניות"},
+			"year": {PluralOther: "{0} שנים"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "בעוד יום {0}", PluralOther: "בעוד {0} ימים"},
+				ContextPast:   {PluralOne: "לפני יום {0}", PluralOther: "לפני {0} ימים"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "בעוד {0} שעות"},
+				ContextPast:   {PluralOther: "לפני {0} שעות"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "בעוד {0} דקות"},
+				ContextPast:   {PluralOther: "לפני {0} דקות"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "בעוד {0} שניות"},
+				ContextPast:   {PluralOther: "לפני {0} שניות"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "בעוד {0} שנים"},
+				ContextPast:   {PluralOther: "לפני {0} שנים"},
+			},
+		},
+	},
+	{
+		Code: "hi",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 0 or n = 1",
+		},
+		Dictionary: map[string]string{
+			"am":                      "am",
+			"d":                       "{0}दि॰",
+			"era_0":                   "ईसा-पूर्व",
+			"era_1":                   "ईस्वी",
+			"h":                       "{0}घं॰",
+			"indian_era_0":            "शक",
+			"indian_month_1":          "चैत्र",
+			"indian_month_10":         "पौष",
+			"indian_month_11":         "माघ",
+			"indian_month_12":         "फाल्गुन",
+			"indian_month_2":          "वैशाख",
+			"indian_month_3":          "ज्येष्ठ",
+			"indian_month_4":          "आषाढ़",
+			"indian_month_5":          "श्रावण",
+			"indian_month_6":          "भाद्रपद",
+			"indian_month_7":          "अश्विन",
+			"indian_month_8":          "कार्तिक",
+			"indian_month_9":          "अग्रहायण",
+			"indian_month_short_1":    "चैत्र",
+			"indian_month_short_10":   "पौष",
+			"indian_month_short_11":   "माघ",
+			"indian_month_short_12":   "फाल्गुन",
+			"indian_month_short_2":    "वैशाख",
+			"indian_month_short_3":    "ज्येष्ठ",
+			"indian_month_short_4":    "आषाढ़",
+			"indian_month_short_5":    "श्रावण",
+			"indian_month_short_6":    "भाद्रपद",
+			"indian_month_short_7":    "अश्विन",
+			"indian_month_short_8":    "कार्तिक",
+			"indian_month_short_9":    "अग्रहायण",
+			"just_now":                "अब",
+			"m":                       "{0}मि॰",
+			"month_1":                 "जनवरी",
+			"month_10":                "अक्तूबर",
+			"month_11":                "नवंबर",
+			"month_12":                "दिसंबर",
+			"month_2":                 "फ़रवरी",
+			"month_3":                 "मार्च",
+			"month_4":                 "अप्रैल",
+			"month_5":                 "मई",
+			"month_6":                 "जून",
+			"month_7":                 "जुलाई",
+			"month_8":                 "अगस्त",
+			"month_9":                 "सितंबर",
+			"month_short_1":           "जन॰",
+			"month_short_10":          "अक्तू॰",
+			"month_short_11":          "नव॰",
+			"month_short_12":          "दिस॰",
+			"month_short_2":           "फ़र॰",
+			"month_short_3":           "मार्च",
+			"month_short_4":           "अप्रैल",
+			"month_short_5":           "मई",
+			"month_short_6":           "जून",
+			"month_short_7":           "जुल॰",
+			"month_short_8":           "अग॰",
+			"month_short_9":           "सित॰",
+			"month_standalone_1":      "जनवरी",
+			"month_standalone_10":     "अक्तूबर",
+			"month_standalone_11":     "नवंबर",
+			"month_standalone_12":     "दिसंबर",
+			"month_standalone_2":      "फ़रवरी",
+			"month_standalone_3":      "मार्च",
+			"month_standalone_4":      "अप्रैल",
+			"month_standalone_5":      "मई",
+			"month_standalone_6":      "जून",
+			"month_standalone_7":      "जुलाई",
+			"month_standalone_8":      "अगस्त",
+			"month_standalone_9":      "सितंबर",
+			"pattern_date_full":       "EEEE, d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/M/yy",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E, d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "h:mm:ss a zzzz",
+			"pattern_time_long":       "h:mm:ss a z",
+			"pattern_time_medium":     "h:mm:ss a",
+			"pattern_time_short":      "h:mm a",
+			"pm":                      "pm",
+			"s":                       "{0}से॰",
+			"weekday_0":               "रविवार",
+			"weekday_1":               "सोमवार",
+			"weekday_2":               "मंगलवार",
+			"weekday_3":               "बुधवार",
+			"weekday_4":               "गुरुवार",
+			"weekday_5":               "शुक्रवार",
+			"weekday_6":               "शनिवार",
+			"weekday_short_0":         "रवि",
+			"weekday_short_1":         "सोम",
+			"weekday_short_2":         "मंगल",
+			"weekday_short_3":         "बुध",
+			"weekday_short_4":         "गुरु",
+			"weekday_short_5":         "शुक्र",
+			"weekday_short_6":         "शनि",
+			"y":                       "{0}व॰",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} दिन", PluralOther: "{0} दिन"},
+			"hour": {PluralOne: "{0} घंटा", PluralOther: "{0} घंटे"},
+			"min":  {PluralOne: "{0} मिनट", PluralOther: "{0} मिनट"},
+			"sec":  {PluralOne: "{0} सेकंड", PluralOther: "{0} सेकंड"},
+			"year": {PluralOne: "{0} वर्ष", PluralOther: "{0} वर्ष"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "{0} दिन में", PluralOther: "{0} दिन में"},
+				ContextPast:   {PluralOne: "{0} दिन पहले", PluralOther: "{0} दिन पहले"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "{0} घंटे में", PluralOther: "{0} घंटे में"},
+				ContextPast:   {PluralOne: "{0} घंटे पहले", PluralOther: "{0} घंटे पहले"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "{0} मिनट में", PluralOther: "{0} मिनट में"},
+				ContextPast:   {PluralOne: "{0} मिनट पहले", PluralOther: "{0} मिनट पहले"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "{0} सेकंड में", PluralOther: "{0} सेकंड में"},
+				ContextPast:   {PluralOne: "{0} सेकंड पहले", PluralOther: "{0} सेकंड पहले"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "{0} वर्ष में", PluralOther: "{0} वर्ष में"},
+				ContextPast:   {PluralOne: "{0} वर्ष पहले", PluralOther: "{0} वर्ष पहले"},
+			},
+		},
+	},
+	{
+		Code: "id",
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0} h",
+			"era_0":                   "SM",
+			"era_1":                   "M",
+			"h":                       "{0} j",
+			"islamic_era_0":           "H",
+			"islamic_month_1":         "Muharram",
+			"islamic_month_10":        "Syawal",
+			"islamic_month_11":        "Zulkaidah",
+			"islamic_month_12":        "Zulhijah",
+			"islamic_month_2":         "Safar",
+			"islamic_month_3":         "Rabiʻ I",
+			"islamic_month_4":         "Rabiʻ II",
+			"islamic_month_5":         "Jumada I",
+			"islamic_month_6":         "Jumada II",
+			"islamic_month_7":         "Rajab",
+			"islamic_month_8":         "Syaban",
+			"islamic_month_9":         "Ramadan",
+			"islamic_month_short_1":   "Muh.",
+			"islamic_month_short_10":  "Syaw.",
+			"islamic_month_short_11":  "Zulka.",
+			"islamic_month_short_12":  "Zulhi.",
+			"islamic_month_short_2":   "Saf.",
+			"islamic_month_short_3":   "Rab. I",
+			"islamic_month_short_4":   "Rab. II",
+			"islamic_month_short_5":   "Jum. I",
+			"islamic_month_short_6":   "Jum. II",
+			"islamic_month_short_7":   "Raj.",
+			"islamic_month_short_8":   "Sya.",
+			"islamic_month_short_9":   "Ram.",
+			"just_now":                "sekarang",
+			"m":                       "{0} mnt",
+			"month_1":                 "Januari",
+			"month_10":                "Oktober",
+			"month_11":                "November",
+			"month_12":                "Desember",
+			"month_2":                 "Februari",
+			"month_3":                 "Maret",
+			"month_4":                 "April",
+			"month_5":                 "Mei",
+			"month_6":                 "Juni",
+			"month_7":                 "Juli",
+			"month_8":                 "Agustus",
+			"month_9":                 "September",
+			"month_short_1":           "Jan",
+			"month_short_10":          "Okt",
+			"month_short_11":          "Nov",
+			"month_short_12":          "Des",
+			"month_short_2":           "Feb",
+			"month_short_3":           "Mar",
+			"month_short_4":           "Apr",
+			"month_short_5":           "Mei",
+			"month_short_6":           "Jun",
+			"month_short_7":           "Jul",
+			"month_short_8":           "Agu",
+			"month_short_9":           "Sep",
+			"month_standalone_1":      "Januari",
+			"month_standalone_10":     "Oktober",
+			"month_standalone_11":     "November",
+			"month_standalone_12":     "Desember",
+			"month_standalone_2":      "Februari",
+			"month_standalone_3":      "Maret",
+			"month_standalone_4":      "April",
+			"month_standalone_5":      "Mei",
+			"month_standalone_6":      "Juni",
+			"month_standalone_7":      "Juli",
+			"month_standalone_8":      "Agustus",
+			"month_standalone_9":      "September",
+			"pattern_date_full":       "EEEE, dd MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/yy",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH.mm",
+			"pattern_skeleton_Hms":    "HH.mm.ss",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E, d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h.mm a",
+			"pattern_skeleton_hms":    "h.mm.ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "HH.mm.ss zzzz",
+			"pattern_time_long":       "HH.mm.ss z",
+			"pattern_time_medium":     "HH.mm.ss",
+			"pattern_time_short":      "HH.mm",
+			"pm":                      "PM",
+			"s":                       "{0} d",
+			"weekday_0":               "Minggu",
+			"weekday_1":               "Senin",
+			"weekday_2":               "Selasa",
+			"weekday_3":               "Rabu",
+			"weekday_4":               "Kamis",
+			"weekday_5":               "Jumat",
+			"weekday_6":               "Sabtu",
+			"weekday_short_0":         "Min",
+			"weekday_short_1":         "Sen",
+			"weekday_short_2":         "Sel",
+			"weekday_short_3":         "Rab",
+			"weekday_short_4":         "Kam",
+			"weekday_short_5":         "Jum",
+			"weekday_short_6":         "Sab",
+			"y":                       "{0} thn",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} hari"},
+			"hour": {PluralOther: "{0} jam"},
+			"min":  {PluralOther: "{0} menit"},
+			"sec":  {PluralOther: "{0} detik"},
+			"year": {PluralOther: "{0} tahun"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "dalam {0} hari"},
+				ContextPast:   {PluralOther: "{0} hari yang lalu"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "dalam {0} jam"},
+				ContextPast:   {PluralOther: "{0} jam yang lalu"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "dalam {0} menit"},
+				ContextPast:   {PluralOther: "{0} menit yang lalu"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "dalam {0} detik"},
+				ContextPast:   {PluralOther: "{0} detik yang lalu"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "dalam {0} tahun"},
+				ContextPast:   {PluralOther: "{0} tahun yang lalu"},
+			},
+		},
+	},
+	{
+		Code: "it",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "i = 1 and v = 0",
+			PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+		},
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0}g",
+			"era_0":                   "a.C.",
+			"era_1":                   "d.C.",
+			"h":                       "{0}h",
+			"just_now":                "ora",
+			"m":                       "{0}min",
+			"month_1":                 "gennaio",
+			"month_10":                "ottobre",
+			"month_11":                "novembre",
+			"month_12":                "dicembre",
+			"month_2":                 "febbraio",
+			"month_3":                 "marzo",
+			"month_4":                 "aprile",
+			"month_5":                 "maggio",
+			"month_6":                 "giugno",
+			"month_7":                 "luglio",
+			"month_8":                 "agosto",
+			"month_9":                 "settembre",
+			"month_short_1":           "gen",
+			"month_short_10":          "ott",
+			"month_short_11":          "nov",
+			"month_short_12":          "dic",
+			"month_short_2":           "feb",
+			"month_short_3":           "mar",
+			"month_short_4":           "apr",
+			"month_short_5":           "mag",
+			"month_short_6":           "giu",
+			"month_short_7":           "lug",
+			"month_short_8":           "ago",
+			"month_short_9":           "set",
+			"month_standalone_1":      "gennaio",
+			"month_standalone_10":     "ottobre",
+			"month_standalone_11":     "novembre",
+			"month_standalone_12":     "dicembre",
+			"month_standalone_2":      "febbraio",
+			"month_standalone_3":      "marzo",
+			"month_standalone_4":      "aprile",
+			"month_standalone_5":      "maggio",
+			"month_standalone_6":      "giugno",
+			"month_standalone_7":      "luglio",
+			"month_standalone_8":      "agosto",
+			"month_standalone_9":      "settembre",
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd/MM/yy",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "PM",
+			"s":                       "{0}s",
+			"weekday_0":               "domenica",
+			"weekday_1":               "lunedì",
+			"weekday_2":               "martedì",
+			"weekday_3":               "mercoledì",
+			"weekday_4":               "giovedì",
+			"weekday_5":               "venerdì",
+			"weekday_6":               "sabato",
+			"weekday_short_0":         "dom",
+			"weekday_short_1":         "lun",
+			"weekday_short_2":         "mar",
+			"weekday_short_3":         "mer",
+			"weekday_short_4":         "gio",
+			"weekday_short_5":         "ven",
+			"weekday_short_6":         "sab",
+			"y":                       "{0}a",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} giorno", PluralMany: "{0} giorni", PluralOther: "{0} giorni"},
+			"hour": {PluralOne: "{0} ora", PluralMany: "{0} ore", PluralOther: "{0} ore"},
+			"min":  {PluralOne: "{0} minuto", PluralMany: "{0} minuti", PluralOther: "{0} minuti"},
+			"sec":  {PluralOne: "{0} secondo", PluralMany: "{0} secondi", PluralOther: "{0} secondi"},
+			"year": {PluralOne: "{0} anno", PluralMany: "{0} anni", PluralOther: "{0} anni"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "tra {0} giorno", PluralMany: "tra {0} giorni", PluralOther: "tra {0} giorni"},
+				ContextPast:   {PluralOne: "{0} giorno fa", PluralMany: "{0} giorni fa", PluralOther: "{0} giorni fa"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "tra {0} ora", PluralMany: "tra {0} ore", PluralOther: "tra {0} ore"},
+				ContextPast:   {PluralOne: "{0} ora fa", PluralMany: "{0} ore fa", PluralOther: "{0} ore fa"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "tra {0} minuto", PluralMany: "tra {0} minuti", PluralOther: "tra {0} minuti"},
+				ContextPast:   {PluralOne: "{0} minuto fa", PluralMany: "{0} minuti fa", PluralOther: "{0} minuti fa"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "tra {0} secondo", PluralMany: "tra {0} secondi", PluralOther: "tra {0} secondi"},
+				ContextPast:   {PluralOne: "{0} secondo fa", PluralMany: "{0} secondi fa", PluralOther: "{0} secondi fa"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "tra {0} anno", PluralMany: "tra {0} anni", PluralOther: "tra {0} anni"},
+				ContextPast:   {PluralOne: "{0} anno fa", PluralMany: "{0} anni fa", PluralOther: "{0} anni fa"},
+			},
+		},
+	},
+	{
+		Code: "ja",
+		Dictionary: map[string]string{
+			"am":                      "午前",
+			"d":                       "{0}日",
+			"era_0":                   "紀元前",
+			"era_1":                   "西暦",
+			"h":                       "{0}時間",
+			"japanese_era_232":        "明治",
+			"japanese_era_233":        "大正",
+			"japanese_era_234":        "昭和",
+			"japanese_era_235":        "平成",
+			"japanese_era_236":        "令和",
+			"japanese_era_narrow_232": "M",
+			"japanese_era_narrow_233": "T",
+			"japanese_era_narrow_234": "S",
+			"japanese_era_narrow_235": "H",
+			"japanese_era_narrow_236": "R",
+			"just_now":                "今",
+			"m":                       "{0}分",
+			"month_1":                 "1月",
+			"month_10":                "10月",
+			"month_11":                "11月",
+			"month_12":                "12月",
+			"month_2":                 "2月",
+			"month_3":                 "3月",
+			"month_4":                 "4月",
+			"month_5":                 "5月",
+			"month_6":                 "6月",
+			"month_7":                 "7月",
+			"month_8":                 "8月",
+			"month_9":                 "9月",
+			"month_short_1":           "1月",
+			"month_short_10":          "10月",
+			"month_short_11":          "11月",
+			"month_short_12":          "12月",
+			"month_short_2":           "2月",
+			"month_short_3":           "3月",
+			"month_short_4":           "4月",
+			"month_short_5":           "5月",
+			"month_short_6":           "6月",
+			"month_short_7":           "7月",
+			"month_short_8":           "8月",
+			"month_short_9":           "9月",
+			"month_standalone_1":      "1月",
+			"month_standalone_10":     "10月",
+			"month_standalone_11":     "11月",
+			"month_standalone_12":     "12月",
+			"month_standalone_2":      "2月",
+			"month_standalone_3":      "3月",
+			"month_standalone_4":      "4月",
+			"month_standalone_5":      "5月",
+			"month_standalone_6":      "6月",
+			"month_standalone_7":      "7月",
+			"month_standalone_8":      "8月",
+			"month_standalone_9":      "9月",
+			"pattern_date_full":       "y年M月d日EEEE",
+			"pattern_date_long":       "y年M月d日",
+			"pattern_date_medium":     "y/MM/dd",
+			"pattern_date_short":      "y/MM/dd",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "Gy年M月d日",
+			"pattern_skeleton_Hm":     "H:mm",
+			"pattern_skeleton_Hms":    "H:mm:ss",
+			"pattern_skeleton_MEd":    "M/d(E)",
+			"pattern_skeleton_MMMEd":  "M月d日(E)",
+			"pattern_skeleton_MMMMd":  "M月d日",
+			"pattern_skeleton_MMMd":   "M月d日",
+			"pattern_skeleton_Md":     "M/d",
+			"pattern_skeleton_hm":     "aK:mm",
+			"pattern_skeleton_hms":    "aK:mm:ss",
+			"pattern_skeleton_yM":     "y/M",
+			"pattern_skeleton_yMEd":   "y/M/d(E)",
+			"pattern_skeleton_yMMM":   "y年M月",
+			"pattern_skeleton_yMMMEd": "y年M月d日(E)",
+			"pattern_skeleton_yMMMM":  "y年M月",
+			"pattern_skeleton_yMMMd":  "y年M月d日",
+			"pattern_skeleton_yMd":    "y/M/d",
+			"pattern_time_full":       "H時mm分ss秒 zzzz",
+			"pattern_time_long":       "H:mm:ss z",
+			"pattern_time_medium":     "H:mm:ss",
+			"pattern_time_short":      "H:mm",
+			"pm":                      "午後",
+			"s":                       "{0}秒",
+			"weekday_0":               "日曜日",
+			"weekday_1":               "月曜日",
+			"weekday_2":               "火曜日",
+			"weekday_3":               "水曜日",
+			"weekday_4":               "木曜日",
+			"weekday_5":               "金曜日",
+			"weekday_6":               "土曜日",
+			"weekday_short_0":         "日",
+			"weekday_short_1":         "月",
+			"weekday_short_2":         "火",
+			"weekday_short_3":         "水",
+			"weekday_short_4":         "木",
+			"weekday_short_5":         "金",
+			"weekday_short_6":         "土",
+			"y":                       "{0}年",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} 日"},
+			"hour": {PluralOther: "{0} 時間"},
+			"min":  {PluralOther: "{0} 分"},
+			"sec":  {PluralOther: "{0} 秒"},
+			"year": {PluralOther: "{0} 年"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "{0} 日後"},
+				ContextPast:   {PluralOther: "{0} 日前"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "{0} 時間後"},
+				ContextPast:   {PluralOther: "{0} 時間前"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "{0} 分後"},
+				ContextPast:   {PluralOther: "{0} 分前"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "{0} 秒後"},
+				ContextPast:   {PluralOther: "{0} 秒前"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "{0} 年後"},
+				ContextPast:   {PluralOther: "{0} 年前"},
+			},
+		},
+	},
+	{
+		Code: "ko",
+		Dictionary: map[string]string{
+			"am":                      "오전",
+			"d":                       "{0}일",
+			"era_0":                   "BC",
+			"era_1":                   "AD",
+			"h":                       "{0}시간",
+			"just_now":                "지금",
+			"m":                       "{0}분",
+			"month_1":                 "1월",
+			"month_10":                "10월",
+			"month_11":                "11월",
+			"month_12":                "12월",
+			"month_2":                 "2월",
+			"month_3":                 "3월",
+			"month_4":                 "4월",
+			"month_5":                 "5월",
+			"month_6":                 "6월",
+			"month_7":                 "7월",
+			"month_8":                 "8월",
+			"month_9":                 "9월",
+			"month_short_1":           "1월",
+			"month_short_10":          "10월",
+			"month_short_11":          "11월",
+			"month_short_12":          "12월",
+			"month_short_2":           "2월",
+			"month_short_3":           "3월",
+			"month_short_4":           "4월",
+			"month_short_5":           "5월",
+			"month_short_6":           "6월",
+			"month_short_7":           "7월",
+			"month_short_8":           "8월",
+			"month_short_9":           "9월",
+			"month_standalone_1":      "1월",
+			"month_standalone_10":     "10월",
+			"month_standalone_11":     "11월",
+			"month_standalone_12":     "12월",
+			"month_standalone_2":      "2월",
+			"month_standalone_3":      "3월",
+			"month_standalone_4":      "4월",
+			"month_standalone_5":      "5월",
+			"month_standalone_6":      "6월",
+			"month_standalone_7":      "7월",
+			"month_standalone_8":      "8월",
+			"month_standalone_9":      "9월",
+			"pattern_date_full":       "y년 MMMM d일 EEEE",
+			"pattern_date_long":       "y년 MMMM d일",
+			"pattern_date_medium":     "y. M. d.",
+			"pattern_date_short":      "yy. M. d.",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "G y년 MMM d일",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "H시 m분 s초",
+			"pattern_skeleton_MEd":    "M. d. (E)",
+			"pattern_skeleton_MMMEd":  "MMM d일 (E)",
+			"pattern_skeleton_MMMMd":  "MMMM d일",
+			"pattern_skeleton_MMMd":   "MMM d일",
+			"pattern_skeleton_Md":     "M. d.",
+			"pattern_skeleton_hm":     "a h:mm",
+			"pattern_skeleton_hms":    "a h:mm:ss",
+			"pattern_skeleton_yM":     "y. M.",
+			"pattern_skeleton_yMEd":   "y. M. d. (E)",
+			"pattern_skeleton_yMMM":   "y년 MMM",
+			"pattern_skeleton_yMMMEd": "y년 MMM d일 (E)",
+			"pattern_skeleton_yMMMM":  "y년 MMMM",
+			"pattern_skeleton_yMMMd":  "y년 MMM d일",
+			"pattern_skeleton_yMd":    "y. M. d.",
+			"pattern_time_full":       "a h시 m분 s초 zzzz",
+			"pattern_time_long":       "a h시 m분 s초 z",
+			"pattern_time_medium":     "a h:mm:ss",
+			"pattern_time_short":      "a h:mm",
+			"pm":                      "오후",
+			"s":                       "{0}초",
+			"weekday_0":               "일요일",
+			"weekday_1":               "월요일",
+			"weekday_2":               "화요일",
+			"weekday_3":               "수요일",
+			"weekday_4":               "목요일",
+			"weekday_5":               "금요일",
+			"weekday_6":               "토요일",
+			"weekday_short_0":         "일",
+			"weekday_short_1":         "월",
+			"weekday_short_2":         "화",
+			"weekday_short_3":         "수",
+			"weekday_short_4":         "목",
+			"weekday_short_5":         "금",
+			"weekday_short_6":         "토",
+			"y":                       "{0}년",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0}일"},
+			"hour": {PluralOther: "{0}시간"},
+			"min":  {PluralOther: "{0}분"},
+			"sec":  {PluralOther: "{0}초"},
+			"year": {PluralOther: "{0}년"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "{0}일 후"},
+				ContextPast:   {PluralOther: "{0}일 전"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "{0}시간 후"},
+				ContextPast:   {PluralOther: "{0}시간 전"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "{0}분 후"},
+				ContextPast:   {PluralOther: "{0}분 전"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "{0}초 후"},
+				ContextPast:   {PluralOther: "{0}초 전"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "{0}년 후"},
+				ContextPast:   {PluralOther: "{0}년 전"},
+			},
+		},
+	},
+	{
+		Code: "ms",
+		Dictionary: map[string]string{
+			"am":                      "PG",
+			"d":                       "{0}h",
+			"era_0":                   "S.M.",
+			"era_1":                   "TM",
+			"h":                       "{0}j",
+			"islamic_era_0":           "H",
+			"islamic_month_1":         "Muharam",
+			"islamic_month_10":        "Syawal",
+			"islamic_month_11":        "Zulkaedah",
+			"islamic_month_12":        "Zulhijah",
+			"islamic_month_2":         "Safar",
+			"islamic_month_3":         "Rabiulawal",
+			"islamic_month_4":         "Rabiulakhir",
+			"islamic_month_5":         "Jamadilawal",
+			"islamic_month_6":         "Jamadilakhir",
+			"islamic_month_7":         "Rejab",
+			"islamic_month_8":         "Syaaban",
+			"islamic_month_9":         "Ramadan",
+			"islamic_month_short_1":   "Muh.",
+			"islamic_month_short_10":  "Syaw.",
+			"islamic_month_short_11":  "Zulka.",
+			"islamic_month_short_12":  "Zulhi.",
+			"islamic_month_short_2":   "Saf.",
+			"islamic_month_short_3":   "Rab. I",
+			"islamic_month_short_4":   "Rab. II",
+			"islamic_month_short_5":   "Jum. I",
+			"islamic_month_short_6":   "Jum. II",
+			"islamic_month_short_7":   "Rej.",
+			"islamic_month_short_8":   "Sya.",
+			"islamic_month_short_9":   "Ram.",
+			"just_now":                "sekarang",
+			"m":                       "{0}m",
+			"month_1":                 "Januari",
+			"month_10":                "Oktober",
+			"month_11":                "November",
+			"month_12":                "Disember",
+			"month_2":                 "Februari",
+			"month_3":                 "Mac",
+			"month_4":                 "April",
+			"month_5":                 "Mei",
+			"month_6":                 "Jun",
+			"month_7":                 "Julai",
+			"month_8":                 "Ogos",
+			"month_9":                 "September",
+			"month_short_1":           "Jan",
+			"month_short_10":          "Okt",
+			"month_short_11":          "Nov",
+			"month_short_12":          "Dis",
+			"month_short_2":           "Feb",
+			"month_short_3":           "Mac",
+			"month_short_4":           "Apr",
+			"month_short_5":           "Mei",
+			"month_short_6":           "Jun",
+			"month_short_7":           "Jul",
+			"month_short_8":           "Ogo",
+			"month_short_9":           "Sep",
+			"month_standalone_1":      "Januari",
+			"month_standalone_10":     "Oktober",
+			"month_standalone_11":     "November",
+			"month_standalone_12":     "Disember",
+			"month_standalone_2":      "Februari",
+			"month_standalone_3":      "Mac",
+			"month_standalone_4":      "April",
+			"month_standalone_5":      "Mei",
+			"month_standalone_6":      "Jun",
+			"month_standalone_7":      "Julai",
+			"month_standalone_8":      "Ogos",
+			"month_standalone_9":      "September",
+			"pattern_date_full":       "EEEE, d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/MM/yy",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, d-M",
+			"pattern_skeleton_MMMEd":  "E, d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d-M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M-y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "h:mm:ss a zzzz",
+			"pattern_time_long":       "h:mm:ss a z",
+			"pattern_time_medium":     "h:mm:ss a",
+			"pattern_time_short":      "h:mm a",
+			"pm":                      "PTG",
+			"s":                       "{0}s",
+			"weekday_0":               "Ahad",
+			"weekday_1":               "Isnin",
+			"weekday_2":               "Selasa",
+			"weekday_3":               "Rabu",
+			"weekday_4":               "Khamis",
+			"weekday_5":               "Jumaat",
+			"weekday_6":               "Sabtu",
+			"weekday_short_0":         "Ahd",
+			"weekday_short_1":         "Isn",
+			"weekday_short_2":         "Sel",
+			"weekday_short_3":         "Rab",
+			"weekday_short_4":         "Kha",
+			"weekday_short_5":         "Jum",
+			"weekday_short_6":         "Sab",
+			"y":                       "{0}t",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} hari"},
+			"hour": {PluralOther: "{0} jam"},
+			"min":  {PluralOther: "{0} minit"},
+			"sec":  {PluralOther: "{0} saat"},
+			"year": {PluralOther: "{0} tahun"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "dalam {0} hari"},
+				ContextPast:   {PluralOther: "{0} hari lalu"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "dalam {0} jam"},
+				ContextPast:   {PluralOther: "{0} jam lalu"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "dalam {0} minit"},
+				ContextPast:   {PluralOther: "{0} minit lalu"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "dalam {0} saat"},
+				ContextPast:   {PluralOther: "{0} saat lalu"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "dalam {0} tahun"},
+				ContextPast:   {PluralOther: "{0} tahun lalu"},
+			},
+		},
+	},
+	{
+		Code: "nl",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "i = 1 and v = 0",
+		},
+		Dictionary: map[string]string{
+			"am":                      "a.m.",
+			"d":                       "{0}d",
+			"era_0":                   "v.Chr.",
+			"era_1":                   "n.Chr.",
+			"h":                       "{0}u",
+			"just_now":                "nu",
+			"m":                       "{0}m",
+			"month_1":                 "januari",
+			"month_10":                "oktober",
+			"month_11":                "november",
+			"month_12":                "december",
+			"month_2":                 "februari",
+			"month_3":                 "maart",
+			"month_4":                 "april",
+			"month_5":                 "mei",
+			"month_6":                 "juni",
+			"month_7":                 "juli",
+			"month_8":                 "augustus",
+			"month_9":                 "september",
+			"month_short_1":           "jan",
+			"month_short_10":          "okt",
+			"month_short_11":          "nov",
+			"month_short_12":          "dec",
+			"month_short_2":           "feb",
+			"month_short_3":           "mrt",
+			"month_short_4":           "apr",
+			"month_short_5":           "mei",
+			"month_short_6":           "jun",
+			"month_short_7":           "jul",
+			"month_short_8":           "aug",
+			"month_short_9":           "sep",
+			"month_standalone_1":      "januari",
+			"month_standalone_10":     "oktober",
+			"month_standalone_11":     "november",
+			"month_standalone_12":     "december",
+			"month_standalone_2":      "februari",
+			"month_standalone_3":      "maart",
+			"month_standalone_4":      "april",
+			"month_standalone_5":      "mei",
+			"month_standalone_6":      "juni",
+			"month_standalone_7":      "juli",
+			"month_standalone_8":      "augustus",
+			"month_standalone_9":      "september",
+			"pattern_date_full":       "EEEE d MMMM y",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "dd-MM-y",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E d-M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d-M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M-y",
+			"pattern_skeleton_yMEd":   "E d-M-y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d-M-y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "p.m.",
+			"s":                       "{0}s",
+			"weekday_0":               "zondag",
+			"weekday_1":               "maandag",
+			"weekday_2":               "dinsdag",
+			"weekday_3":               "woensdag",
+			"weekday_4":               "donderdag",
+			"weekday_5":               "vrijdag",
+			"weekday_6":               "zaterdag",
+			"weekday_short_0":         "zo",
+			"weekday_short_1":         "ma",
+			"weekday_short_2":         "di",
+			"weekday_short_3":         "wo",
+			"weekday_short_4":         "do",
+			"weekday_short_5":         "vr",
+			"weekday_short_6":         "za",
+			"y":                       "{0}j",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} dag", PluralOther: "{0} dagen"},
+			"hour": {PluralOne: "{0} uur", PluralOther: "{0} uur"},
+			"min":  {PluralOne: "{0} minuut", PluralOther: "{0} minuten"},
+			"sec":  {PluralOne: "{0} seconde", PluralOther: "{0} seconden"},
+			"year": {PluralOne: "{0} jaar", PluralOther: "{0} jaar"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "over {0} dag", PluralOther: "over {0} dagen"},
+				ContextPast:   {PluralOne: "{0} dag geleden", PluralOther: "{0} dagen geleden"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "over {0} uur", PluralOther: "over {0} uur"},
+				ContextPast:   {PluralOne: "{0} uur geleden", PluralOther: "{0} uur geleden"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "over {0} minuut", PluralOther: "over {0} minuten"},
+				ContextPast:   {PluralOne: "{0} minuut geleden", PluralOther: "{0} minuten geleden"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "over {0} seconde", PluralOther: "over {0} seconden"},
+				ContextPast:   {PluralOne: "{0} seconde geleden", PluralOther: "{0} seconden geleden"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "over {0} jaar", PluralOther: "over {0} jaar"},
+				ContextPast:   {PluralOne: "{0} jaar geleden", PluralOther: "{0} jaar geleden"},
+			},
+		},
+	},
+	{
+		Code: "pt",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "i = 0..1",
+			PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+		},
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0}d",
+			"era_0":                   "a.C.",
+			"era_1":                   "d.C.",
+			"h":                       "{0}h",
+			"just_now":                "agora",
+			"m":                       "{0}min",
+			"month_1":                 "janeiro",
+			"month_10":                "outubro",
+			"month_11":                "novembro",
+			"month_12":                "dezembro",
+			"month_2":                 "fevereiro",
+			"month_3":                 "março",
+			"month_4":                 "abril",
+			"month_5":                 "maio",
+			"month_6":                 "junho",
+			"month_7":                 "julho",
+			"month_8":                 "agosto",
+			"month_9":                 "setembro",
+			"month_short_1":           "jan.",
+			"month_short_10":          "out.",
+			"month_short_11":          "nov.",
+			"month_short_12":          "dez.",
+			"month_short_2":           "fev.",
+			"month_short_3":           "mar.",
+			"month_short_4":           "abr.",
+			"month_short_5":           "mai.",
+			"month_short_6":           "jun.",
+			"month_short_7":           "jul.",
+			"month_short_8":           "ago.",
+			"month_short_9":           "set.",
+			"month_standalone_1":      "janeiro",
+			"month_standalone_10":     "outubro",
+			"month_standalone_11":     "novembro",
+			"month_standalone_12":     "dezembro",
+			"month_standalone_2":      "fevereiro",
+			"month_standalone_3":      "março",
+			"month_standalone_4":      "abril",
+			"month_standalone_5":      "maio",
+			"month_standalone_6":      "junho",
+			"month_standalone_7":      "julho",
+			"month_standalone_8":      "agosto",
+			"month_standalone_9":      "setembro",
+			"pattern_date_full":       "EEEE, d 'de' MMMM 'de' y",
+			"pattern_date_long":       "d 'de' MMMM 'de' y",
+			"pattern_date_medium":     "d 'de' MMM 'de' y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "d 'de' MMM 'de' y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, dd/MM",
+			"pattern_skeleton_MMMEd":  "E, d 'de' MMM",
+			"pattern_skeleton_MMMMd":  "d 'de' MMMM",
+			"pattern_skeleton_MMMd":   "d 'de' MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "MM/y",
+			"pattern_skeleton_yMEd":   "E, dd/MM/y",
+			"pattern_skeleton_yMMM":   "MMM 'de' y",
+			"pattern_skeleton_yMMMEd": "E, d 'de' MMM 'de' y",
+			"pattern_skeleton_yMMMM":  "MMMM 'de' y",
+			"pattern_skeleton_yMMMd":  "d 'de' MMM 'de' y",
+			"pattern_skeleton_yMd":    "dd/MM/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "PM",
+			"s":                       "{0}s",
+			"weekday_0":               "domingo",
+			"weekday_1":               "segunda-feira",
+			"weekday_2":               "terça-feira",
+			"weekday_3":               "quarta-feira",
+			"weekday_4":               "quinta-feira",
+			"weekday_5":               "sexta-feira",
+			"weekday_6":               "sábado",
+			"weekday_short_0":         "dom.",
+			"weekday_short_1":         "seg.",
+			"weekday_short_2":         "ter.",
+			"weekday_short_3":         "qua.",
+			"weekday_short_4":         "qui.",
+			"weekday_short_5":         "sex.",
+			"weekday_short_6":         "sáb.",
+			"y":                       "{0}a",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} dia", PluralMany: "{0} dias", PluralOther: "{0} dias"},
+			"hour": {PluralOne: "{0} hora", PluralMany: "{0} horas", PluralOther: "{0} horas"},
+			"min":  {PluralOne: "{0} minuto", PluralMany: "{0} minutos", PluralOther: "{0} minutos"},
+			"sec":  {PluralOne: "{0} segundo", PluralMany: "{0} segundos", PluralOther: "{0} segundos"},
+			"year": {PluralOne: "{0} ano", PluralMany: "{0} anos", PluralOther: "{0} anos"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "em {0} dia", PluralMany: "em {0} dias", PluralOther: "em {0} dias"},
+				ContextPast:   {PluralOne: "há {0} dia", PluralMany: "há {0} dias", PluralOther: "há {0} dias"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "em {0} hora", PluralMany: "em {0} horas", PluralOther: "em {0} horas"},
+				ContextPast:   {PluralOne: "há {0} hora", PluralMany: "há {0} horas", PluralOther: "há {0} horas"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "em {0} minuto", PluralMany: "em {0} minutos", PluralOther: "em {0} minutos"},
+				ContextPast:   {PluralOne: "há {0} minuto", PluralMany: "há {0} minutos", PluralOther: "há {0} minutos"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "em {0} segundo", PluralMany: "em {0} segundos", PluralOther: "em {0} segundos"},
+				ContextPast:   {PluralOne: "há {0} segundo", PluralMany: "há {0} segundos", PluralOther: "há {0} segundos"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "em {0} ano", PluralMany: "em {0} anos", PluralOther: "em {0} anos"},
+				ContextPast:   {PluralOne: "há {0} ano", PluralMany: "há {0} anos", PluralOther: "há {0} anos"},
+			},
+		},
+	},
+	{
+		Code: "ru",
+		PluralRules: map[PluralCategory]string{
+			PluralOne:  "v = 0 and i % 10 = 1 and i % 100 != 11",
+			PluralFew:  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+			PluralMany: "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+		},
+		Dictionary: map[string]string{
+			"am":                      "AM",
+			"d":                       "{0} д",
+			"era_0":                   "до н. э.",
+			"era_1":                   "н. э.",
+			"h":                       "{0} ч",
+			"just_now":                "сейчас",
+			"m":                       "{0} мин",
+			"month_1":                 "января",
+			"month_10":                "октября",
+			"month_11":                "ноября",
+			"month_12":                "декабря",
+			"month_2":                 "февраля",
+			"month_3":                 "марта",
+			"month_4":                 "апреля",
+			"month_5":                 "мая",
+			"month_6":                 "июня",
+			"month_7":                 "июля",
+			"month_8":                 "августа",
+			"month_9":                 "сентября",
+			"month_short_1":           "янв.",
+			"month_short_10":          "окт.",
+			"month_short_11":          "нояб.",
+			"month_short_12":          "дек.",
+			"month_short_2":           "февр.",
+			"month_short_3":           "мар.",
+			"month_short_4":           "апр.",
+			"month_short_5":           "мая",
+			"month_short_6":           "июн.",
+			"month_short_7":           "июл.",
+			"month_short_8":           "авг.",
+			"month_short_9":           "сент.",
+			"month_standalone_1":      "январь",
+			"month_standalone_10":     "октябрь",
+			"month_standalone_11":     "ноябрь",
+			"month_standalone_12":     "декабрь",
+			"month_standalone_2":      "февраль",
+			"month_standalone_3":      "март",
+			"month_standalone_4":      "апрель",
+			"month_standalone_5":      "май",
+			"month_standalone_6":      "июнь",
+			"month_standalone_7":      "июль",
+			"month_standalone_8":      "август",
+			"month_standalone_9":      "сентябрь",
+			"pattern_date_full":       "EEEE, d MMMM y 'г'.",
+			"pattern_date_long":       "d MMMM y 'г'.",
+			"pattern_date_medium":     "d MMM y 'г'.",
+			"pattern_date_short":      "dd.MM.y",
+			"pattern_datetime":        "{1}, {0}",
+			"pattern_skeleton_GyMMMd": "d MMM y 'г'. G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, dd.MM",
+			"pattern_skeleton_MMMEd":  "ccc, d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "dd.MM",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "MM.y",
+			"pattern_skeleton_yMEd":   "ccc, dd.MM.y 'г'.",
+			"pattern_skeleton_yMMM":   "LLL y 'г'.",
+			"pattern_skeleton_yMMMEd": "E, d MMM y 'г'.",
+			"pattern_skeleton_yMMMM":  "LLLL y 'г'.",
+			"pattern_skeleton_yMMMd":  "d MMM y 'г'.",
+			"pattern_skeleton_yMd":    "dd.MM.y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "PM",
+			"s":                       "{0} с",
+			"weekday_0":               "воскресенье",
+			"weekday_1":               "понедельник",
+			"weekday_2":               "вторник",
+			"weekday_3":               "среда",
+			"weekday_4":               "четверг",
+			"weekday_5":               "пятница",
+			"weekday_6":               "суббота",
+			"weekday_short_0":         "вс",
+			"weekday_short_1":         "пн",
+			"weekday_short_2":         "вт",
+			"weekday_short_3":         "ср",
+			"weekday_short_4":         "чт",
+			"weekday_short_5":         "пт",
+			"weekday_short_6":         "сб",
+			"y":                       "{0} г.",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} день", PluralFew: "{0} дня", PluralMany: "{0} дней", PluralOther: "{0} дня"},
+			"hour": {PluralOne: "{0} час", PluralFew: "{0} часа", PluralMany: "{0} часов", PluralOther: "{0} часа"},
+			"min":  {PluralOne: "{0} минута", PluralFew: "{0} минуты", PluralMany: "{0} минут", PluralOther: "{0} минуты"},
+			"sec":  {PluralOne: "{0} секунда", PluralFew: "{0} секунды", PluralMany: "{0} секунд", PluralOther: "{0} секунды"},
+			"year": {PluralOne: "{0} год", PluralFew: "{0} года", PluralMany: "{0} лет", PluralOther: "{0} года"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "через {0} день", PluralFew: "через {0} дня", PluralMany: "через {0} дней", PluralOther: "через {0} дня"},
+				ContextPast:   {PluralOne: "{0} день назад", PluralFew: "{0} дня назад", PluralMany: "{0} дней назад", PluralOther: "{0} дня назад"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "через {0} час", PluralFew: "через {0} часа", PluralMany: "через {0} часов", PluralOther: "через {0} часа"},
+				ContextPast:   {PluralOne: "{0} час назад", PluralFew: "{0} часа назад", PluralMany: "{0} часов назад", PluralOther: "{0} часа назад"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "через {0} минуту", PluralFew: "через {0} минуты", PluralMany: "через {0} минут", PluralOther: "через {0} минуты"},
+				ContextPast:   {PluralOne: "{0} минуту назад", PluralFew: "{0} минуты назад", PluralMany: "{0} минут назад", PluralOther: "{0} минуты назад"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "через {0} секунду", PluralFew: "через {0} секунды", PluralMany: "через {0} секунд", PluralOther: "через {0} секунды"},
+				ContextPast:   {PluralOne: "{0} секунду назад", PluralFew: "{0} секунды назад", PluralMany: "{0} секунд назад", PluralOther: "{0} секунды назад"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "через {0} год", PluralFew: "через {0} года", PluralMany: "через {0} лет", PluralOther: "через {0} года"},
+				ContextPast:   {PluralOne: "{0} год назад", PluralFew: "{0} года назад", PluralMany: "{0} лет назад", PluralOther: "{0} года назад"},
+			},
+		},
+	},
+	{
+		Code: "th",
+		Dictionary: map[string]string{
+			"am":                      "ก่อนเที่ยง",
+			"buddhist_era_0":          "พ.ศ.",
+			"d":                       "{0}ว.",
+			"era_0":                   "ก่อน ค.ศ.",
+			"era_1":                   "ค.ศ.",
+			"h":                       "{0}ชม.",
+			"just_now":                "ขณะนี้",
+			"m":                       "{0}น.",
+			"month_1":                 "มกราคม",
+			"month_10":                "ตุลาคม",
+			"month_11":                "พฤศจิกายน",
+			"month_12":                "ธันวาคม",
+			"month_2":                 "กุมภาพันธ์",
+			"month_3":                 "มีนาคม",
+			"month_4":                 "เมษายน",
+			"month_5":                 "พฤษภาคม",
+			"month_6":                 "มิถุนายน",
+			"month_7":                 "กรกฎาคม",
+			"month_8":                 "สิงหาคม",
+			"month_9":                 "กันยายน",
+			"month_short_1":           "ม.ค.",
+			"month_short_10":          "ต.ค.",
+			"month_short_11":          "พ.ย.",
+			"month_short_12":          "ธ.ค.",
+			"month_short_2":           "ก.พ.",
+			"month_short_3":           "มี.ค.",
+			"month_short_4":           "เม.ย.",
+			"month_short_5":           "พ.ค.",
+			"month_short_6":           "มิ.ย.",
+			"month_short_7":           "ก.ค.",
+			"month_short_8":           "ส.ค.",
+			"month_short_9":           "ก.ย.",
+			"month_standalone_1":      "มกราคม",
+			"month_standalone_10":     "ตุลาคม",
+			"month_standalone_11":     "พฤศจิกายน",
+			"month_standalone_12":     "ธันวาคม",
+			"month_standalone_2":      "กุมภาพันธ์",
+			"month_standalone_3":      "มีนาคม",
+			"month_standalone_4":      "เมษายน",
+			"month_standalone_5":      "พฤษภาคม",
+			"month_standalone_6":      "มิถุนายน",
+			"month_standalone_7":      "กรกฎาคม",
+			"month_standalone_8":      "สิงหาคม",
+			"month_standalone_9":      "กันยายน",
+			"pattern_date_full":       "EEEEที่ d MMMM G y",
+			"pattern_date_long":       "d MMMM G y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d/M/yy",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "d MMM G y",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E d/M",
+			"pattern_skeleton_MMMEd":  "E d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E d MMM y",
+			"pattern_skeleton_yMMMM":  "MMMM G y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "H นาฬิกา mm นาที ss วินาที zzzz",
+			"pattern_time_long":       "H นาฬิกา mm นาที ss วินาที z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "หลังเที่ยง",
+			"s":                       "{0}วิ",
+			"weekday_0":               "วันอาทิตย์",
+			"weekday_1":               "วันจันทร์",
+			"weekday_2":               "วันอังคาร",
+			"weekday_3":               "วันพุธ",
+			"weekday_4":               "วันพฤหัสบดี",
+			"weekday_5":               "วันศุกร์",
+			"weekday_6":               "วันเสาร์",
+			"weekday_short_0":         "อา.",
+			"weekday_short_1":         "จ.",
+			"weekday_short_2":         "อ.",
+			"weekday_short_3":         "พ.",
+			"weekday_short_4":         "พฤ.",
+			"weekday_short_5":         "ศ.",
+			"weekday_short_6":         "ส.",
+			"y":                       "{0}ปี",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} วัน"},
+			"hour": {PluralOther: "{0} ชั่วโมง"},
+			"min":  {PluralOther: "{0} นาที"},
+			"sec":  {PluralOther: "{0} วินาที"},
+			"year": {PluralOther: "{0} ปี"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "ในอีก {0} วัน"},
+				ContextPast:   {PluralOther: "{0} วันที่ผ่านมา"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "ในอีก {0} ชั่วโมง"},
+				ContextPast:   {PluralOther: "{0} ชั่วโมงที่ผ่านมา"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "ในอีก {0} นาที"},
+				ContextPast:   {PluralOther: "{0} นาทีที่ผ่านมา"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "ในอีก {0} วินาที"},
+				ContextPast:   {PluralOther: "{0} วินาทีที่ผ่านมา"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "ในอีก {0} ปี"},
+				ContextPast:   {PluralOther: "{0} ปีที่แล้ว"},
+			},
+		},
+	},
+	{
+		Code: "tr",
+		PluralRules: map[PluralCategory]string{
+			PluralOne: "n = 1",
+		},
+		Dictionary: map[string]string{
+			"am":                      "ÖÖ",
+			"d":                       "{0}g",
+			"era_0":                   "MÖ",
+			"era_1":                   "MS",
+			"h":                       "{0}sa",
+			"islamic_era_0":           "Hicri",
+			"islamic_month_1":         "Muharrem",
+			"islamic_month_10":        "Şevval",
+			"islamic_month_11":        "Zilkade",
+			"islamic_month_12":        "Zilhicce",
+			"islamic_month_2":         "Safer",
+			"islamic_month_3":         "Rebiülevvel",
+			"islamic_month_4":         "Rebiülahir",
+			"islamic_month_5":         "Cemaziyelevvel",
+			"islamic_month_6":         "Cemaziyelahir",
+			"islamic_month_7":         "Recep",
+			"islamic_month_8":         "Şaban",
+			"islamic_month_9":         "Ramazan",
+			"islamic_month_short_1":   "Muh.",
+			"islamic_month_short_10":  "Şev.",
+			"islamic_month_short_11":  "Zilk.",
+			"islamic_month_short_12":  "Zilh.",
+			"islamic_month_short_2":   "Saf.",
+			"islamic_month_short_3":   "Reb. I",
+			"islamic_month_short_4":   "Reb. II",
+			"islamic_month_short_5":   "Cem. I",
+			"islamic_month_short_6":   "Cem. II",
+			"islamic_month_short_7":   "Rec.",
+			"islamic_month_short_8":   "Şab.",
+			"islamic_month_short_9":   "Ram.",
+			"just_now":                "şimdi",
+			"m":                       "{0}dk",
+			"month_1":                 "Ocak",
+			"month_10":                "Ekim",
+			"month_11":                "Kasım",
+			"month_12":                "Aralık",
+			"month_2":                 "Şubat",
+			"month_3":                 "Mart",
+			"month_4":                 "Nisan",
+			"month_5":                 "Mayıs",
+			"month_6":                 "Haziran",
+			"month_7":                 "Temmuz",
+			"month_8":                 "Ağustos",
+			"month_9":                 "Eylül",
+			"month_short_1":           "Oca",
+			"month_short_10":          "Eki",
+			"month_short_11":          "Kas",
+			"month_short_12":          "Ara",
+			"month_short_2":           "Şub",
+			"month_short_3":           "Mar",
+			"month_short_4":           "Nis",
+			"month_short_5":           "May",
+			"month_short_6":           "Haz",
+			"month_short_7":           "Tem",
+			"month_short_8":           "Ağu",
+			"month_short_9":           "Eyl",
+			"month_standalone_1":      "Ocak",
+			"month_standalone_10":     "Ekim",
+			"month_standalone_11":     "Kasım",
+			"month_standalone_12":     "Aralık",
+			"month_standalone_2":      "Şubat",
+			"month_standalone_3":      "Mart",
+			"month_standalone_4":      "Nisan",
+			"month_standalone_5":      "Mayıs",
+			"month_standalone_6":      "Haziran",
+			"month_standalone_7":      "Temmuz",
+			"month_standalone_8":      "Ağustos",
+			"month_standalone_9":      "Eylül",
+			"pattern_date_full":       "d MMMM y EEEE",
+			"pattern_date_long":       "d MMMM y",
+			"pattern_date_medium":     "d MMM y",
+			"pattern_date_short":      "d.MM.y",
+			"pattern_datetime":        "{1} {0}",
+			"pattern_skeleton_GyMMMd": "G dd MMM y",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "d/MM E",
+			"pattern_skeleton_MMMEd":  "d MMMM E",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "a h:mm",
+			"pattern_skeleton_hms":    "a h:mm:ss",
+			"pattern_skeleton_yM":     "MM/y",
+			"pattern_skeleton_yMEd":   "d.M.y E",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "d MMM y E",
+			"pattern_skeleton_yMMMM":  "MMMM y",
+			"pattern_skeleton_yMMMd":  "d MMM y",
+			"pattern_skeleton_yMd":    "dd.MM.y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "ÖS",
+			"s":                       "{0}sn",
+			"weekday_0":               "Pazar",
+			"weekday_1":               "Pazartesi",
+			"weekday_2":               "Salı",
+			"weekday_3":               "Çarşamba",
+			"weekday_4":               "Perşembe",
+			"weekday_5":               "Cuma",
+			"weekday_6":               "Cumartesi",
+			"weekday_short_0":         "Paz",
+			"weekday_short_1":         "Pzt",
+			"weekday_short_2":         "Sal",
+			"weekday_short_3":         "Çar",
+			"weekday_short_4":         "Per",
+			"weekday_short_5":         "Cum",
+			"weekday_short_6":         "Cmt",
+			"y":                       "{0}y",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOne: "{0} gün", PluralOther: "{0} gün"},
+			"hour": {PluralOne: "{0} saat", PluralOther: "{0} saat"},
+			"min":  {PluralOne: "{0} dakika", PluralOther: "{0} dakika"},
+			"sec":  {PluralOne: "{0} saniye", PluralOther: "{0} saniye"},
+			"year": {PluralOne: "{0} yıl", PluralOther: "{0} yıl"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOne: "{0} gün sonra", PluralOther: "{0} gün sonra"},
+				ContextPast:   {PluralOne: "{0} gün önce", PluralOther: "{0} gün önce"},
+			},
+			"hour": {
+				ContextFuture: {PluralOne: "{0} saat sonra", PluralOther: "{0} saat sonra"},
+				ContextPast:   {PluralOne: "{0} saat önce", PluralOther: "{0} saat önce"},
+			},
+			"min": {
+				ContextFuture: {PluralOne: "{0} dakika sonra", PluralOther: "{0} dakika sonra"},
+				ContextPast:   {PluralOne: "{0} dakika önce", PluralOther: "{0} dakika önce"},
+			},
+			"sec": {
+				ContextFuture: {PluralOne: "{0} saniye sonra", PluralOther: "{0} saniye sonra"},
+				ContextPast:   {PluralOne: "{0} saniye önce", PluralOther: "{0} saniye önce"},
+			},
+			"year": {
+				ContextFuture: {PluralOne: "{0} yıl sonra", PluralOther: "{0} yıl sonra"},
+				ContextPast:   {PluralOne: "{0} yıl önce", PluralOther: "{0} yıl önce"},
+			},
+		},
+	},
+	{
+		Code: "vi",
+		Dictionary: map[string]string{
+			"am":                      "SA",
+			"d":                       "{0}d",
+			"era_0":                   "Trước CN",
+			"era_1":                   "Sau CN",
+			"h":                       "{0}h",
+			"just_now":                "bây giờ",
+			"m":                       "{0}ph",
+			"month_1":                 "tháng 1",
+			"month_10":                "tháng 10",
+			"month_11":                "tháng 11",
+			"month_12":                "tháng 12",
+			"month_2":                 "tháng 2",
+			"month_3":                 "tháng 3",
+			"month_4":                 "tháng 4",
+			"month_5":                 "tháng 5",
+			"month_6":                 "tháng 6",
+			"month_7":                 "tháng 7",
+			"month_8":                 "tháng 8",
+			"month_9":                 "tháng 9",
+			"month_short_1":           "thg 1",
+			"month_short_10":          "thg 10",
+			"month_short_11":          "thg 11",
+			"month_short_12":          "thg 12",
+			"month_short_2":           "thg 2",
+			"month_short_3":           "thg 3",
+			"month_short_4":           "thg 4",
+			"month_short_5":           "thg 5",
+			"month_short_6":           "thg 6",
+			"month_short_7":           "thg 7",
+			"month_short_8":           "thg 8",
+			"month_short_9":           "thg 9",
+			"month_standalone_1":      "Tháng 1",
+			"month_standalone_10":     "Tháng 10",
+			"month_standalone_11":     "Tháng 11",
+			"month_standalone_12":     "Tháng 12",
+			"month_standalone_2":      "Tháng 2",
+			"month_standalone_3":      "Tháng 3",
+			"month_standalone_4":      "Tháng 4",
+			"month_standalone_5":      "Tháng 5",
+			"month_standalone_6":      "Tháng 6",
+			"month_standalone_7":      "Tháng 7",
+			"month_standalone_8":      "Tháng 8",
+			"month_standalone_9":      "Tháng 9",
+			"pattern_date_full":       "EEEE, d MMMM, y",
+			"pattern_date_long":       "d MMMM, y",
+			"pattern_date_medium":     "d MMM, y",
+			"pattern_date_short":      "dd/MM/y",
+			"pattern_datetime":        "{0} {1}",
+			"pattern_skeleton_GyMMMd": "d MMM, y G",
+			"pattern_skeleton_Hm":     "HH:mm",
+			"pattern_skeleton_Hms":    "HH:mm:ss",
+			"pattern_skeleton_MEd":    "E, d/M",
+			"pattern_skeleton_MMMEd":  "E, d MMM",
+			"pattern_skeleton_MMMMd":  "d MMMM",
+			"pattern_skeleton_MMMd":   "d MMM",
+			"pattern_skeleton_Md":     "d/M",
+			"pattern_skeleton_hm":     "h:mm a",
+			"pattern_skeleton_hms":    "h:mm:ss a",
+			"pattern_skeleton_yM":     "M/y",
+			"pattern_skeleton_yMEd":   "E, d/M/y",
+			"pattern_skeleton_yMMM":   "MMM y",
+			"pattern_skeleton_yMMMEd": "E, d MMM, y",
+			"pattern_skeleton_yMMMM":  "MMMM 'năm' y",
+			"pattern_skeleton_yMMMd":  "d MMM, y",
+			"pattern_skeleton_yMd":    "d/M/y",
+			"pattern_time_full":       "HH:mm:ss zzzz",
+			"pattern_time_long":       "HH:mm:ss z",
+			"pattern_time_medium":     "HH:mm:ss",
+			"pattern_time_short":      "HH:mm",
+			"pm":                      "CH",
+			"s":                       "{0}s",
+			"weekday_0":               "Chủ Nhật",
+			"weekday_1":               "Thứ Hai",
+			"weekday_2":               "Thứ Ba",
+			"weekday_3":               "Thứ Tư",
+			"weekday_4":               "Thứ Năm",
+			"weekday_5":               "Thứ Sáu",
+			"weekday_6":               "Thứ Bảy",
+			"weekday_short_0":         "CN",
+			"weekday_short_1":         "Th 2",
+			"weekday_short_2":         "Th 3",
+			"weekday_short_3":         "Th 4",
+			"weekday_short_4":         "Th 5",
+			"weekday_short_5":         "Th 6",
+			"weekday_short_6":         "Th 7",
+			"y":                       "{0} năm",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} ngày"},
+			"hour": {PluralOther: "{0} giờ"},
+			"min":  {PluralOther: "{0} phút"},
+			"sec":  {PluralOther: "{0} giây"},
+			"year": {PluralOther: "{0} năm"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "sau {0} ngày nữa"},
+				ContextPast:   {PluralOther: "{0} ngày trước"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "sau {0} giờ nữa"},
+				ContextPast:   {PluralOther: "{0} giờ trước"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "sau {0} phút nữa"},
+				ContextPast:   {PluralOther: "{0} phút trước"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "sau {0} giây nữa"},
+				ContextPast:   {PluralOther: "{0} giây trước"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "sau {0} năm nữa"},
+				ContextPast:   {PluralOther: "{0} năm trước"},
+			},
+		},
+	},
+	{
+		Code: "zh",
+		Dictionary: map[string]string{
+			"am":                         "上午",
+			"chinese_era_0":              "甲子",
+			"chinese_era_1":              "乙丑",
+			"chinese_era_10":             "甲戌",
+			"chinese_era_11":             "乙亥",
+			"chinese_era_12":             "丙子",
+			"chinese_era_13":             "丁丑",
+			"chinese_era_14":             "戊寅",
+			"chinese_era_15":             "己卯",
+			"chinese_era_16":             "庚辰",
+			"chinese_era_17":             "辛巳",
+			"chinese_era_18":             "壬午",
+			"chinese_era_19":             "癸未",
+			"chinese_era_2":              "丙寅",
+			"chinese_era_20":             "甲申",
+			"chinese_era_21":             "乙酉",
+			"chinese_era_22":             "丙戌",
+			"chinese_era_23":             "丁亥",
+			"chinese_era_24":             "戊子",
+			"chinese_era_25":             "己丑",
+			"chinese_era_26":             "庚寅",
+			"chinese_era_27":             "辛卯",
+			"chinese_era_28":             "壬辰",
+			"chinese_era_29":             "癸巳",
+			"chinese_era_3":              "丁卯",
+			"chinese_era_30":             "甲午",
+			"chinese_era_31":             "乙未",
+			"chinese_era_32":             "丙申",
+			"chinese_era_33":             "丁酉",
+			"chinese_era_34":             "戊戌",
+			"chinese_era_35":             "己亥",
+			"chinese_era_36":             "庚子",
+			"chinese_era_37":             "辛丑",
+			"chinese_era_38":             "壬寅",
+			"chinese_era_39":             "癸卯",
+			"chinese_era_4":              "戊辰",
+			"chinese_era_40":             "甲辰",
+			"chinese_era_41":             "乙巳",
+			"chinese_era_42":             "丙午",
+			"chinese_era_43":             "丁未",
+			"chinese_era_44":             "戊申",
+			"chinese_era_45":             "己酉",
+			"chinese_era_46":             "庚戌",
+			"chinese_era_47":             "辛亥",
+			"chinese_era_48":             "壬子",
+			"chinese_era_49":             "癸丑",
+			"chinese_era_5":              "己巳",
+			"chinese_era_50":             "甲寅",
+			"chinese_era_51":             "乙卯",
+			"chinese_era_52":             "丙辰",
+			"chinese_era_53":             "丁巳",
+			"chinese_era_54":             "戊午",
+			"chinese_era_55":             "己未",
+			"chinese_era_56":             "庚申",
+			"chinese_era_57":             "辛酉",
+			"chinese_era_58":             "壬戌",
+			"chinese_era_59":             "癸亥",
+			"chinese_era_6":              "庚午",
+			"chinese_era_7":              "辛未",
+			"chinese_era_8":              "壬申",
+			"chinese_era_9":              "癸酉",
+			"chinese_month_1":            "正月",
+			"chinese_month_10":           "十月",
+			"chinese_month_11":           "冬月",
+			"chinese_month_12":           "腊月",
+			"chinese_month_2":            "二月",
+			"chinese_month_3":            "三月",
+			"chinese_month_4":            "四月",
+			"chinese_month_5":            "五月",
+			"chinese_month_6":            "六月",
+			"chinese_month_7":            "七月",
+			"chinese_month_8":            "八月",
+			"chinese_month_9":            "九月",
+			"chinese_month_pattern_leap": "闰{0}",
+			"chinese_month_short_1":      "正月",
+			"chinese_month_short_10":     "十月",
+			"chinese_month_short_11":     "冬月",
+			"chinese_month_short_12":     "腊月",
+			"chinese_month_short_2":      "二月",
+			"chinese_month_short_3":      "三月",
+			"chinese_month_short_4":      "四月",
+			"chinese_month_short_5":      "五月",
+			"chinese_month_short_6":      "六月",
+			"chinese_month_short_7":      "七月",
+			"chinese_month_short_8":      "八月",
+			"chinese_month_short_9":      "九月",
+			"chinese_solar_term_1":       "立春",
+			"chinese_solar_term_10":      "夏至",
+			"chinese_solar_term_11":      "小暑",
+			"chinese_solar_term_12":      "大暑",
+			"chinese_solar_term_13":      "立秋",
+			"chinese_solar_term_14":      "处暑",
+			"chinese_solar_term_15":      "白露",
+			"chinese_solar_term_16":      "秋分",
+			"chinese_solar_term_17":      "寒露",
+			"chinese_solar_term_18":      "霜降",
+			"chinese_solar_term_19":      "立冬",
+			"chinese_solar_term_2":       "雨水",
+			"chinese_solar_term_20":      "小雪",
+			"chinese_solar_term_21":      "大雪",
+			"chinese_solar_term_22":      "冬至",
+			"chinese_solar_term_23":      "小寒",
+			"chinese_solar_term_24":      "大寒",
+			"chinese_solar_term_3":       "惊蛰",
+			"chinese_solar_term_4":       "春分",
+			"chinese_solar_term_5":       "清明",
+			"chinese_solar_term_6":       "谷雨",
+			"chinese_solar_term_7":       "立夏",
+			"chinese_solar_term_8":       "小满",
+			"chinese_solar_term_9":       "芒种",
+			"chinese_zodiac_1":           "鼠",
+			"chinese_zodiac_10":          "鸡",
+			"chinese_zodiac_11":          "狗",
+			"chinese_zodiac_12":          "猪",
+			"chinese_zodiac_2":           "牛",
+			"chinese_zodiac_3":           "虎",
+			"chinese_zodiac_4":           "兔",
+			"chinese_zodiac_5":           "龙",
+			"chinese_zodiac_6":           "蛇",
+			"chinese_zodiac_7":           "马",
+			"chinese_zodiac_8":           "羊",
+			"chinese_zodiac_9":           "猴",
+			"d":                          "{0}天",
+			"era_0":                      "公元前",
+			"era_1":                      "公元",
+			"h":                          "{0}小时",
+			"just_now":                   "现在",
+			"m":                          "{0}分",
+			"month_1":                    "一月",
+			"month_10":                   "十月",
+			"month_11":                   "十一月",
+			"month_12":                   "十二月",
+			"month_2":                    "二月",
+			"month_3":                    "三月",
+			"month_4":                    "四月",
+			"month_5":                    "五月",
+			"month_6":                    "六月",
+			"month_7":                    "七月",
+			"month_8":                    "八月",
+			"month_9":                    "九月",
+			"month_short_1":              "1月",
+			"month_short_10":             "10月",
+			"month_short_11":             "11月",
+			"month_short_12":             "12月",
+			"month_short_2":              "2月",
+			"month_short_3":              "3月",
+			"month_short_4":              "4月",
+			"month_short_5":              "5月",
+			"month_short_6":              "6月",
+			"month_short_7":              "7月",
+			"month_short_8":              "8月",
+			"month_short_9":              "9月",
+			"month_standalone_1":         "一月",
+			"month_standalone_10":        "十月",
+			"month_standalone_11":        "十一月",
+			"month_standalone_12":        "十二月",
+			"month_standalone_2":         "二月",
+			"month_standalone_3":         "三月",
+			"month_standalone_4":         "四月",
+			"month_standalone_5":         "五月",
+			"month_standalone_6":         "六月",
+			"month_standalone_7":         "七月",
+			"month_standalone_8":         "八月",
+			"month_standalone_9":         "九月",
+			"pattern_date_full":          "y年M月d日EEEE",
+			"pattern_date_long":          "y年M月d日",
+			"pattern_date_medium":        "y年M月d日",
+			"pattern_date_short":         "y/M/d",
+			"pattern_datetime":           "{1} {0}",
+			"pattern_skeleton_GyMMMd":    "Gy年M月d日",
+			"pattern_skeleton_Hm":        "HH:mm",
+			"pattern_skeleton_Hms":       "HH:mm:ss",
+			"pattern_skeleton_MEd":       "M/dE",
+			"pattern_skeleton_MMMEd":     "M月d日E",
+			"pattern_skeleton_MMMMd":     "M月d日",
+			"pattern_skeleton_MMMd":      "M月d日",
+			"pattern_skeleton_Md":        "M/d",
+			"pattern_skeleton_hm":        "ah:mm",
+			"pattern_skeleton_hms":       "ah:mm:ss",
+			"pattern_skeleton_yM":        "y/M",
+			"pattern_skeleton_yMEd":      "y/M/dE",
+			"pattern_skeleton_yMMM":      "y年M月",
+			"pattern_skeleton_yMMMEd":    "y年M月d日E",
+			"pattern_skeleton_yMMMM":     "y年M月",
+			"pattern_skeleton_yMMMd":     "y年M月d日",
+			"pattern_skeleton_yMd":       "y/M/d",
+			"pattern_time_full":          "zzzz HH:mm:ss",
+			"pattern_time_long":          "z HH:mm:ss",
+			"pattern_time_medium":        "HH:mm:ss",
+			"pattern_time_short":         "HH:mm",
+			"pm":                         "下午",
+			"roc_era_0":                  "民国前",
+			"roc_era_1":                  "民国",
+			"s":                          "{0}秒",
+			"weekday_0":                  "星期日",
+			"weekday_1":                  "星期一",
+			"weekday_2":                  "星期二",
+			"weekday_3":                  "星期三",
+			"weekday_4":                  "星期四",
+			"weekday_5":                  "星期五",
+			"weekday_6":                  "星期六",
+			"weekday_short_0":            "周日",
+			"weekday_short_1":            "周一",
+			"weekday_short_2":            "周二",
+			"weekday_short_3":            "周三",
+			"weekday_short_4":            "周四",
+			"weekday_short_5":            "周五",
+			"weekday_short_6":            "周六",
+			"y":                          "{0}年",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0}天"},
+			"hour": {PluralOther: "{0}小时"},
+			"min":  {PluralOther: "{0}分钟"},
+			"sec":  {PluralOther: "{0}秒钟"},
+			"year": {PluralOther: "{0}年"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "{0}天后"},
+				ContextPast:   {PluralOther: "{0}天前"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "{0}小时后"},
+				ContextPast:   {PluralOther: "{0}小时前"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "{0}分钟后"},
+				ContextPast:   {PluralOther: "{0}分钟前"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "{0}秒钟后"},
+				ContextPast:   {PluralOther: "{0}秒钟前"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "{0}年后"},
+				ContextPast:   {PluralOther: "{0}年前"},
+			},
+		},
+	},
+	{
+		Code: "zh-Hant",
+		Dictionary: map[string]string{
+			"am":                         "上午",
+			"chinese_era_0":              "甲子",
+			"chinese_era_1":              "乙丑",
+			"chinese_era_10":             "甲戌",
+			"chinese_era_11":             "乙亥",
+			"chinese_era_12":             "丙子",
+			"chinese_era_13":             "丁丑",
+			"chinese_era_14":             "戊寅",
+			"chinese_era_15":             "己卯",
+			"chinese_era_16":             "庚辰",
+			"chinese_era_17":             "辛巳",
+			"chinese_era_18":             "壬午",
+			"chinese_era_19":             "癸未",
+			"chinese_era_2":              "丙寅",
+			"chinese_era_20":             "甲申",
+			"chinese_era_21":             "乙酉",
+			"chinese_era_22":             "丙戌",
+			"chinese_era_23":             "丁亥",
+			"chinese_era_24":             "戊子",
+			"chinese_era_25":             "己丑",
+			"chinese_era_26":             "庚寅",
+			"chinese_era_27":             "辛卯",
+			"chinese_era_28":             "壬辰",
+			"chinese_era_29":             "癸巳",
+			"chinese_era_3":              "丁卯",
+			"chinese_era_30":             "甲午",
+			"chinese_era_31":             "乙未",
+			"chinese_era_32":             "丙申",
+			"chinese_era_33":             "丁酉",
+			"chinese_era_34":             "戊戌",
+			"chinese_era_35":             "己亥",
+			"chinese_era_36":             "庚子",
+			"chinese_era_37":             "辛丑",
+			"chinese_era_38":             "壬寅",
+			"chinese_era_39":             "癸卯",
+			"chinese_era_4":              "戊辰",
+			"chinese_era_40":             "甲辰",
+			"chinese_era_41":             "乙巳",
+			"chinese_era_42":             "丙午",
+			"chinese_era_43":             "丁未",
+			"chinese_era_44":             "戊申",
+			"chinese_era_45":             "己酉",
+			"chinese_era_46":             "庚戌",
+			"chinese_era_47":             "辛亥",
+			"chinese_era_48":             "壬子",
+			"chinese_era_49":             "癸丑",
+			"chinese_era_5":              "己巳",
+			"chinese_era_50":             "甲寅",
+			"chinese_era_51":             "乙卯",
+			"chinese_era_52":             "丙辰",
+			"chinese_era_53":             "丁巳",
+			"chinese_era_54":             "戊午",
+			"chinese_era_55":             "己未",
+			"chinese_era_56":             "庚申",
+			"chinese_era_57":             "辛酉",
+			"chinese_era_58":             "壬戌",
+			"chinese_era_59":             "癸亥",
+			"chinese_era_6":              "庚午",
+			"chinese_era_7":              "辛未",
+			"chinese_era_8":              "壬申",
+			"chinese_era_9":              "癸酉",
+			"chinese_month_1":            "正月",
+			"chinese_month_10":           "十月",
+			"chinese_month_11":           "冬月",
+			"chinese_month_12":           "臘月",
+			"chinese_month_2":            "二月",
+			"chinese_month_3":            "三月",
+			"chinese_month_4":            "四月",
+			"chinese_month_5":            "五月",
+			"chinese_month_6":            "六月",
+			"chinese_month_7":            "七月",
+			"chinese_month_8":            "八月",
+			"chinese_month_9":            "九月",
+			"chinese_month_pattern_leap": "閏{0}",
+			"chinese_month_short_1":      "正月",
+			"chinese_month_short_10":     "十月",
+			"chinese_month_short_11":     "冬月",
+			"chinese_month_short_12":     "臘月",
+			"chinese_month_short_2":      "二月",
+			"chinese_month_short_3":      "三月",
+			"chinese_month_short_4":      "四月",
+			"chinese_month_short_5":      "五月",
+			"chinese_month_short_6":      "六月",
+			"chinese_month_short_7":      "七月",
+			"chinese_month_short_8":      "八月",
+			"chinese_month_short_9":      "九月",
+			"chinese_solar_term_1":       "立春",
+			"chinese_solar_term_10":      "夏至",
+			"chinese_solar_term_11":      "小暑",
+			"chinese_solar_term_12":      "大暑",
+			"chinese_solar_term_13":      "立秋",
+			"chinese_solar_term_14":      "處暑",
+			"chinese_solar_term_15":      "白露",
+			"chinese_solar_term_16":      "秋分",
+			"chinese_solar_term_17":      "寒露",
+			"chinese_solar_term_18":      "霜降",
+			"chinese_solar_term_19":      "立冬",
+			"chinese_solar_term_2":       "雨水",
+			"chinese_solar_term_20":      "小雪",
+			"chinese_solar_term_21":      "大雪",
+			"chinese_solar_term_22":      "冬至",
+			"chinese_solar_term_23":      "小寒",
+			"chinese_solar_term_24":      "大寒",
+			"chinese_solar_term_3":       "驚蟄",
+			"chinese_solar_term_4":       "春分",
+			"chinese_solar_term_5":       "清明",
+			"chinese_solar_term_6":       "穀雨",
+			"chinese_solar_term_7":       "立夏",
+			"chinese_solar_term_8":       "小滿",
+			"chinese_solar_term_9":       "芒種",
+			"chinese_zodiac_1":           "鼠",
+			"chinese_zodiac_10":          "雞",
+			"chinese_zodiac_11":          "狗",
+			"chinese_zodiac_12":          "豬",
+			"chinese_zodiac_2":           "牛",
+			"chinese_zodiac_3":           "虎",
+			"chinese_zodiac_4":           "兔",
+			"chinese_zodiac_5":           "龍",
+			"chinese_zodiac_6":           "蛇",
+			"chinese_zodiac_7":           "馬",
+			"chinese_zodiac_8":           "羊",
+			"chinese_zodiac_9":           "猴",
+			"d":                          "{0}天",
+			"era_0":                      "西元前",
+			"era_1":                      "西元",
+			"h":                          "{0}小時",
+			"just_now":                   "現在",
+			"m":                          "{0}分",
+			"month_1":                    "1月",
+			"month_10":                   "10月",
+			"month_11":                   "11月",
+			"month_12":                   "12月",
+			"month_2":                    "2月",
+			"month_3":                    "3月",
+			"month_4":                    "4月",
+			"month_5":                    "5月",
+			"month_6":                    "6月",
+			"month_7":                    "7月",
+			"month_8":                    "8月",
+			"month_9":                    "9月",
+			"month_short_1":              "1月",
+			"month_short_10":             "10月",
+			"month_short_11":             "11月",
+			"month_short_12":             "12月",
+			"month_short_2":              "2月",
+			"month_short_3":              "3月",
+			"month_short_4":              "4月",
+			"month_short_5":              "5月",
+			"month_short_6":              "6月",
+			"month_short_7":              "7月",
+			"month_short_8":              "8月",
+			"month_short_9":              "9月",
+			"month_standalone_1":         "1月",
+			"month_standalone_10":        "10月",
+			"month_standalone_11":        "11月",
+			"month_standalone_12":        "12月",
+			"month_standalone_2":         "2月",
+			"month_standalone_3":         "3月",
+			"month_standalone_4":         "4月",
+			"month_standalone_5":         "5月",
+			"month_standalone_6":         "6月",
+			"month_standalone_7":         "7月",
+			"month_standalone_8":         "8月",
+			"month_standalone_9":         "9月",
+			"pattern_date_full":          "y年M月d日 EEEE",
+			"pattern_date_long":          "y年M月d日",
+			"pattern_date_medium":        "y年M月d日",
+			"pattern_date_short":         "y/M/d",
+			"pattern_datetime":           "{1} {0}",
+			"pattern_skeleton_GyMMMd":    "Gy年M月d日",
+			"pattern_skeleton_Hm":        "HH:mm",
+			"pattern_skeleton_Hms":       "HH:mm:ss",
+			"pattern_skeleton_MEd":       "M/d（E）",
+			"pattern_skeleton_MMMEd":     "M月d日 E",
+			"pattern_skeleton_MMMMd":     "M月d日",
+			"pattern_skeleton_MMMd":      "M月d日",
+			"pattern_skeleton_Md":        "M/d",
+			"pattern_skeleton_hm":        "ah:mm",
+			"pattern_skeleton_hms":       "ah:mm:ss",
+			"pattern_skeleton_yM":        "y/M",
+			"pattern_skeleton_yMEd":      "y/M/d（E）",
+			"pattern_skeleton_yMMM":      "y年M月",
+			"pattern_skeleton_yMMMEd":    "y年M月d日 E",
+			"pattern_skeleton_yMMMM":     "y年M月",
+			"pattern_skeleton_yMMMd":     "y年M月d日",
+			"pattern_skeleton_yMd":       "y/M/d",
+			"pattern_time_full":          "ah:mm:ss [zzzz]",
+			"pattern_time_long":          "ah:mm:ss [z]",
+			"pattern_time_medium":        "ah:mm:ss",
+			"pattern_time_short":         "ah:mm",
+			"pm":                         "下午",
+			"roc_era_0":                  "民國前",
+			"roc_era_1":                  "民國",
+			"s":                          "{0}秒",
+			"weekday_0":                  "星期日",
+			"weekday_1":                  "星期一",
+			"weekday_2":                  "星期二",
+			"weekday_3":                  "星期三",
+			"weekday_4":                  "星期四",
+			"weekday_5":                  "星期五",
+			"weekday_6":                  "星期六",
+			"weekday_short_0":            "週日",
+			"weekday_short_1":            "週一",
+			"weekday_short_2":            "週二",
+			"weekday_short_3":            "週三",
+			"weekday_short_4":            "週四",
+			"weekday_short_5":            "週五",
+			"weekday_short_6":            "週六",
+			"y":                          "{0}年",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"day":  {PluralOther: "{0} 天"},
+			"hour": {PluralOther: "{0} 小時"},
+			"min":  {PluralOther: "{0} 分鐘"},
+			"sec":  {PluralOther: "{0} 秒"},
+			"year": {PluralOther: "{0} 年"},
+		},
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextFuture: {PluralOther: "{0} 天後"},
+				ContextPast:   {PluralOther: "{0} 天前"},
+			},
+			"hour": {
+				ContextFuture: {PluralOther: "{0} 小時後"},
+				ContextPast:   {PluralOther: "{0} 小時前"},
+			},
+			"min": {
+				ContextFuture: {PluralOther: "{0} 分鐘後"},
+				ContextPast:   {PluralOther: "{0} 分鐘前"},
+			},
+			"sec": {
+				ContextFuture: {PluralOther: "{0} 秒後"},
+				ContextPast:   {PluralOther: "{0} 秒前"},
+			},
+			"year": {
+				ContextFuture: {PluralOther: "{0} 年後"},
+				ContextPast:   {PluralOther: "{0} 年前"},
+			},
+		},
+	},
+	{
+		Code: "zh-Hant-HK",
+		Dictionary: map[string]string{
+			"pattern_date_full":     "y年M月d日EEEE",
+			"pattern_date_long":     "y年M月d日",
+			"pattern_date_medium":   "y年M月d日",
+			"pattern_date_short":    "d/M/y",
+			"pattern_skeleton_MEd":  "d/M（E）",
+			"pattern_skeleton_Md":   "d/M",
+			"pattern_skeleton_yM":   "M/y",
+			"pattern_skeleton_yMEd": "d/M/y（E）",
+			"pattern_skeleton_yMd":  "d/M/y",
+			"pattern_time_full":     "ah:mm:ss [zzzz]",
+			"pattern_time_long":     "ah:mm:ss [z]",
+			"pattern_time_medium":   "ah:mm:ss",
+			"pattern_time_short":    "ah:mm",
+		},
+	},
+}
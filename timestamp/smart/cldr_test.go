@@ -0,0 +1,80 @@
+package smart
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCLDRLocales(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		// Languages registered from CLDR data alone
+		{"FR past", Social(now.Add(-5*time.Minute), "fr", StyleStandard), "il y a 5 minutes"},
+		{"ES future", Social(now.Add(3*time.Hour+time.Minute), "es", StyleStandard), "dentro de 3 horas"},
+		{"RU few", Social(now.Add(-22*time.Minute), "ru", StyleStandard), "22 минуты назад"},
+		{"RU many", Social(now.Add(-5*time.Minute), "ru", StyleStandard), "5 минут назад"},
+		{"RU accusative", Social(now.Add(time.Minute+2*time.Second), "ru", StyleStandard), "через 1 минуту"},
+		{"RU duration", Duration(21*time.Minute, "ru"), "21 минута"},
+		{"RU short", Social(now.Add(-5*time.Minute), "ru", StyleShort), "5 мин"},
+		{"FR just now", Social(now.Add(-time.Second), "fr", StyleStandard), "maintenant"},
+		{"KO past", Social(now.Add(-5*time.Minute), "ko", StyleStandard), "5분 전"},
+		{"KO weekday", GetTrans("ko", "weekday_1"), "월요일"},
+		{"ZH future", Social(now.Add(2*time.Hour+time.Minute), "zh", StyleStandard), "2小时后"},
+		{"ZH weekday", GetTrans("zh", "weekday_short_1"), "周一"},
+		{"NL past", Social(now.Add(-5*time.Minute), "nl", StyleStandard), "5 minuten geleden"},
+		{"IT future", Social(now.Add(2*time.Hour+time.Minute), "it", StyleStandard), "tra 2 ore"},
+		{"PT month", GetTrans("pt", "month_3"), "março"},
+		{"TR past", Social(now.Add(-5*time.Minute), "tr", StyleStandard), "5 dakika önce"},
+		{"AR two", Social(now.Add(-2*time.Hour-time.Minute), "ar", StyleStandard), "قبل 2 ساعة"}, // number-less dual is skipped
+		{"AR few", Social(now.Add(-5*time.Hour-time.Minute), "ar", StyleStandard), "قبل 5 ساعات"},
+		{"ZH-Hant past", Social(now.Add(-5*time.Minute), "zh-Hant", StyleStandard), "5 分鐘前"},
+		{"ZH-Hant-HK inherits names", GetTrans("zh-Hant-HK", "weekday_short_1"), "週一"},
+		{"EN-GB inherits names", GetTrans("en-GB", "month_12"), "December"},
+		{"FA past", Social(now.Add(-5*time.Minute), "fa", StyleStandard), "5 دقیقه پیش"},
+		{"FA Persian month", GetTrans("fa", "persian_month_10"), "دی"},
+		{"FA digits", GetTrans("fa", "digits"), "۰۱۲۳۴۵۶۷۸۹"},
+		{"FA-AF month", GetTrans("fa-AF", "persian_month_10"), "جدی"},
+		{"FA-AF inherits digits", GetTrans("fa-AF", "digits"), "۰۱۲۳۴۵۶۷۸۹"},
+		{"HE future", Social(now.Add(3*time.Hour+time.Minute), "he", StyleStandard), "בעוד 3 שעות"},
+		{"HE Hebrew month", GetTrans("he", "hebrew_month_4"), "טבת"},
+		{"EN Hebrew leap month", GetTrans("en", "hebrew_month_leap_7"), "Adar II"},
+		{"ZH Chinese month", GetTrans("zh", "chinese_month_12"), "腊月"},
+		{"ZH Chinese year name", GetTrans("zh", "chinese_era_40"), "甲辰"},
+		{"ZH-Hant leap month", GetTrans("zh-Hant", "chinese_month_pattern_leap"), "閏{0}"},
+		{"EN zodiac", GetTrans("en", "chinese_zodiac_5"), "Dragon"},
+		{"ZH solar term", GetTrans("zh", "chinese_solar_term_1"), "立春"},
+		{"HI past", Social(now.Add(-5*time.Minute), "hi", StyleStandard), "5 मिनट पहले"},
+		{"HI Saka month", GetTrans("hi", "indian_month_10"), "पौष"},
+		{"AM past", Social(now.Add(-3*24*time.Hour), "am", StyleStandard), "ከ3 ቀናት በፊት"},
+		{"AM Ethiopic month", GetTrans("am", "ethiopic_month_13"), "ጳጉሜን"},
+		{"AR Coptic month", GetTrans("ar", "coptic_month_4"), "كيهك"},
+		{"EN Ethiopic era", GetTrans("en", "ethiopic_era_1"), "EC"},
+		{"JA Japanese era", GetTrans("ja", "japanese_era_236"), "令和"},
+		{"JA Japanese narrow era", GetTrans("ja", "japanese_era_narrow_235"), "H"},
+		{"HI hand-written keeps CLDR names", GetTrans("hi", "month_1"), "जनवरी"},
+
+		// Hand-written wording wins over CLDR
+		{"ID hand-written", Social(now.Add(-5*time.Minute), "id", StyleStandard), "5 menit lalu"},
+		{"DE hand-written", Duration(2*time.Hour, "de"), "2 Stunden"},
+
+		// Gaps in hand-written locales are filled from CLDR
+		{"ID month names", Adaptive(time.Date(2001, 12, 25, 0, 0, 0, 0, time.UTC), "id"), "25 Des 2001"},
+		{"TH weekday", GetTrans("th", "weekday_1"), "วันจันทร์"},
+		{"JA pattern", GetTrans("ja", "pattern_date_long"), "y年M月d日"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+
+	if registry["en"].PluralRules[PluralOne] != "i = 1 and v = 0" {
+		t.Errorf("EN plural rule source not filled from CLDR: %v", registry["en"].PluralRules)
+	}
+}
@@ -1,7 +1,6 @@
 package smart
 
 import (
-	"fmt"
 	"strings"
 	"time"
 )
@@ -10,9 +9,18 @@ import (
 // It breaks down time into hours, minutes, and seconds.
 // Zero units are omitted (e.g., "1 hour" instead of "1 hour 0 minutes").
 func Duration(d time.Duration, lang string) string {
+	return defaultFormatter.Duration(d, lang)
+}
+
+// Duration is like the package-level Duration but resolves strings through f's Translator.
+func (f Formatter) Duration(d time.Duration, lang string) string {
+	return pseudoBracket(lang, f.duration(d, lang))
+}
+
+func (f Formatter) duration(d time.Duration, lang string) string {
 	seconds := int(d.Seconds())
 	if seconds == 0 {
-		return "0 " + GetPlural(lang, "sec", 0)
+		return quantity(0, f.plural(lang, "sec", 0, ContextStandalone))
 	}
 
 	h := seconds / 3600
@@ -23,17 +31,17 @@ func Duration(d time.Duration, lang string) string {
 
 	// Hours
 	if h > 0 {
-		parts = append(parts, fmt.Sprintf("%d %s", h, GetPlural(lang, "hour", h)))
+		parts = append(parts, quantity(h, f.plural(lang, "hour", h, ContextStandalone)))
 	}
 
 	// Minutes
 	if m > 0 {
-		parts = append(parts, fmt.Sprintf("%d %s", m, GetPlural(lang, "min", m)))
+		parts = append(parts, quantity(m, f.plural(lang, "min", m, ContextStandalone)))
 	}
 
 	// Seconds
 	if s > 0 {
-		parts = append(parts, fmt.Sprintf("%d %s", s, GetPlural(lang, "sec", s)))
+		parts = append(parts, quantity(s, f.plural(lang, "sec", s, ContextStandalone)))
 	}
 
 	return strings.Join(parts, " ")
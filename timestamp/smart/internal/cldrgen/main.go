@@ -0,0 +1,542 @@
+// Command cldrgen generates the smart package locale tables from a local CLDR
+// JSON snapshot, so new languages can be added without network access at build time.
+//
+// It reads the layout of the official cldr-json distribution, either a single
+// directory containing main/<lang>/*.json and supplemental/plurals.json (like
+// the snapshot checked in under smart/cldr) or the full distribution with
+// cldr-dates-full, cldr-units-full and cldr-core side by side.
+//
+// Usage:
+//
+//	go run ./internal/cldrgen -src cldr -out cldr_data.go
+//	go run ./internal/cldrgen -src ~/cldr-json -locales de,fr,pl -out cldr_data.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// units maps CLDR field/unit names to the smart package unit keys and their short-style keys.
+var units = []struct {
+	cldr, key, short string
+}{
+	{"second", "sec", "s"},
+	{"minute", "min", "m"},
+	{"hour", "hour", "h"},
+	{"day", "day", "d"},
+	{"year", "year", "y"},
+}
+
+var categoryConsts = map[string]string{
+	"zero":  "PluralZero",
+	"one":   "PluralOne",
+	"two":   "PluralTwo",
+	"few":   "PluralFew",
+	"many":  "PluralMany",
+	"other": "PluralOther",
+}
+
+var categoryOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// availableFormats are the skeletons copied from dateTimeFormats.availableFormats.
+var availableFormats = []string{
+	"Hm", "hm", "Hms", "hms", "Md", "MEd", "MMMd", "MMMEd", "MMMMd",
+	"yM", "yMd", "yMEd", "yMMM", "yMMMd", "yMMMEd", "yMMMM", "yMMMMd", "GyMMMd",
+}
+
+// calendars are the non-Gregorian calendars whose month and era names are
+// copied from main/<lang>/ca-<calendar>.json, under keys prefixed with the
+// calendar name ("islamic_month_6").
+var calendars = []string{"buddhist", "chinese", "coptic", "ethiopic", "hebrew", "indian", "islamic", "japanese", "persian", "roc"}
+
+// roots lists the package directories of the official distribution, relative to -src.
+var roots = []string{
+	".",
+	"cldr-core", "cldr-dates-full", "cldr-dates-modern", "cldr-units-full", "cldr-units-modern",
+}
+
+// locale is the generated data for one language, mirroring smart.Locale.
+type locale struct {
+	code        string
+	pluralRules map[string]string
+	dictionary  map[string]string
+	plurals     map[string]map[string]string            // unit -> category -> "{0} Minuten"
+	forms       map[string]map[string]map[string]string // unit -> context -> category -> "vor {0} Minuten"
+}
+
+func main() {
+	src := flag.String("src", "cldr", "directory of the CLDR JSON snapshot")
+	out := flag.String("out", "cldr_data.go", "output Go file")
+	only := flag.String("locales", "", "comma-separated locales to generate (default: all in the snapshot)")
+	flag.Parse()
+
+	codes, err := listLocales(*src, *only)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var plurals pluralsFile
+	if err := readJSON(*src, filepath.Join("supplemental", "plurals.json"), &plurals); err != nil {
+		log.Fatal(err)
+	}
+	var numbering numberingFile
+	if err := readJSON(*src, filepath.Join("supplemental", "numberingSystems.json"), &numbering); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Fatal(err)
+	}
+
+	var locales []locale
+	for _, code := range codes {
+		loc, err := loadLocale(*src, code, plurals, numbering)
+		if err != nil {
+			log.Fatalf("%s: %v", code, err)
+		}
+		locales = append(locales, loc)
+	}
+
+	code, err := render(locales)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// listLocales returns the locale codes found under main/ of any root, or the -locales selection.
+func listLocales(src, only string) ([]string, error) {
+	if only != "" {
+		codes := strings.Split(only, ",")
+		sort.Strings(codes)
+		return codes, nil
+	}
+
+	seen := map[string]bool{}
+	for _, root := range roots {
+		entries, err := os.ReadDir(filepath.Join(src, root, "main"))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				seen[e.Name()] = true
+			}
+		}
+	}
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("no locales found under %s", src)
+	}
+
+	var codes []string
+	for code := range seen {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes, nil
+}
+
+// readJSON decodes the first root that contains rel. Missing files are reported as fs.ErrNotExist.
+func readJSON(src, rel string, v any) error {
+	for _, root := range roots {
+		data, err := os.ReadFile(filepath.Join(src, root, rel))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s: %w", rel, fs.ErrNotExist)
+}
+
+type pluralsFile struct {
+	Supplemental struct {
+		Cardinal map[string]map[string]string `json:"plurals-type-cardinal"`
+	} `json:"supplemental"`
+}
+
+type numberingFile struct {
+	Supplemental struct {
+		NumberingSystems map[string]struct {
+			Digits string `json:"_digits"`
+			Type   string `json:"_type"`
+		} `json:"numberingSystems"`
+	} `json:"supplemental"`
+}
+
+type numbersFile struct {
+	Main map[string]struct {
+		Numbers struct {
+			DefaultNumberingSystem string `json:"defaultNumberingSystem"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+type dateFieldsFile struct {
+	Main map[string]struct {
+		Dates struct {
+			Fields map[string]map[string]json.RawMessage `json:"fields"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+type unitsFile struct {
+	Main map[string]struct {
+		Units map[string]map[string]map[string]string `json:"units"` // width -> unit -> pattern key -> pattern
+	} `json:"main"`
+}
+
+// calendarFile holds the calendars of a ca-<calendar>.json file, keyed by CLDR calendar name.
+type calendarFile struct {
+	Main map[string]struct {
+		Dates struct {
+			Calendars map[string]calendar `json:"calendars"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+type calendar struct {
+	Months          map[string]map[string]map[string]string            `json:"months"` // context -> width -> "1".."12"
+	Days            map[string]map[string]map[string]string            `json:"days"`   // context -> width -> "sun".."sat"
+	DayPeriods      map[string]map[string]map[string]string            `json:"dayPeriods"`
+	Eras            map[string]map[string]string                       `json:"eras"`           // eraAbbr, eraNarrow -> "0", "1"
+	MonthPatterns   map[string]map[string]map[string]string            `json:"monthPatterns"`  // context -> width -> "leap"
+	CyclicNameSets  map[string]map[string]map[string]map[string]string `json:"cyclicNameSets"` // "years" -> context -> width -> "1".."60"
+	DateFormats     map[string]string                                  `json:"dateFormats"`
+	TimeFormats     map[string]string                                  `json:"timeFormats"`
+	DateTimeFormats map[string]json.RawMessage                         `json:"dateTimeFormats"`
+}
+
+func loadLocale(src, code string, plurals pluralsFile, numbering numberingFile) (locale, error) {
+	loc := locale{
+		code:        code,
+		pluralRules: map[string]string{},
+		dictionary:  map[string]string{},
+		plurals:     map[string]map[string]string{},
+		forms:       map[string]map[string]map[string]string{},
+	}
+
+	rules, ok := plurals.Supplemental.Cardinal[code]
+	if !ok {
+		rules = plurals.Supplemental.Cardinal[strings.Split(code, "-")[0]]
+	}
+	for key, rule := range rules {
+		cat := strings.TrimPrefix(key, "pluralRule-count-")
+		if i := strings.Index(rule, "@"); i >= 0 {
+			rule = rule[:i]
+		}
+		if rule = strings.TrimSpace(rule); rule != "" && cat != "other" {
+			loc.pluralRules[cat] = rule
+		}
+	}
+
+	var numbers numbersFile
+	if err := readJSON(src, filepath.Join("main", code, "numbers.json"), &numbers); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return loc, err
+	}
+	if ns := numbers.Main[code].Numbers.DefaultNumberingSystem; ns != "" && ns != "latn" {
+		system, ok := numbering.Supplemental.NumberingSystems[ns]
+		if !ok || system.Type != "numeric" {
+			return loc, fmt.Errorf("numbering system %q has no digits", ns)
+		}
+		loc.dictionary["digits"] = system.Digits
+	}
+
+	var fields dateFieldsFile
+	if err := readJSON(src, filepath.Join("main", code, "dateFields.json"), &fields); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return loc, err
+	}
+	if err := loadRelative(&loc, fields.Main[code].Dates.Fields); err != nil {
+		return loc, err
+	}
+
+	var unitData unitsFile
+	if err := readJSON(src, filepath.Join("main", code, "units.json"), &unitData); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return loc, err
+	}
+	loadUnits(&loc, unitData.Main[code].Units)
+
+	var greg calendarFile
+	if err := readJSON(src, filepath.Join("main", code, "ca-gregorian.json"), &greg); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return loc, err
+	}
+	if err := loadGregorian(&loc, greg.Main[code].Dates.Calendars["gregorian"]); err != nil {
+		return loc, err
+	}
+
+	for _, name := range calendars {
+		var file calendarFile
+		if err := readJSON(src, filepath.Join("main", code, "ca-"+name+".json"), &file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return loc, err
+		}
+		loadCalendarNames(&loc, name, file.Main[code].Dates.Calendars[name])
+	}
+
+	return loc, nil
+}
+
+// loadRelative reads "in {0} minutes" / "{0} minutes ago" patterns from dateFields.json.
+func loadRelative(loc *locale, fields map[string]map[string]json.RawMessage) error {
+	if now, ok := fields["second"]["relative-type-0"]; ok {
+		var s string
+		if err := json.Unmarshal(now, &s); err != nil {
+			return err
+		}
+		loc.dictionary["just_now"] = s
+	}
+
+	for _, u := range units {
+		field, ok := fields[u.cldr]
+		if !ok {
+			continue
+		}
+		for ctx, key := range map[string]string{
+			"ContextPast":   "relativeTime-type-past",
+			"ContextFuture": "relativeTime-type-future",
+		} {
+			raw, ok := field[key]
+			if !ok {
+				continue
+			}
+			var patterns map[string]string
+			if err := json.Unmarshal(raw, &patterns); err != nil {
+				return fmt.Errorf("%s.%s: %w", u.cldr, key, err)
+			}
+			if loc.forms[u.key] == nil {
+				loc.forms[u.key] = map[string]map[string]string{}
+			}
+			loc.forms[u.key][ctx] = byCategory(patterns, "relativeTimePattern-count-")
+		}
+	}
+	return nil
+}
+
+// loadUnits reads duration patterns ("{0} Minuten") and narrow forms ("{0} min") from units.json.
+func loadUnits(loc *locale, widths map[string]map[string]map[string]string) {
+	for _, u := range units {
+		if long, ok := widths["long"]["duration-"+u.cldr]; ok {
+			loc.plurals[u.key] = byCategory(long, "unitPattern-count-")
+		}
+		if narrow, ok := widths["narrow"]["duration-"+u.cldr]; ok {
+			if p, ok := narrow["unitPattern-count-other"]; ok {
+				loc.dictionary[u.short] = p
+			}
+		}
+	}
+}
+
+func loadGregorian(loc *locale, greg calendar) error {
+
+	// Months are keyed 1-12 like time.Month, weekdays 0-6 like time.Weekday.
+	names := []struct {
+		table  map[string]string
+		prefix string
+		keys   []string
+		first  int
+	}{
+		{greg.Months["format"]["wide"], "month_", monthKeys(), 1},
+		{greg.Months["format"]["abbreviated"], "month_short_", monthKeys(), 1},
+		{greg.Months["stand-alone"]["wide"], "month_standalone_", monthKeys(), 1},
+		{greg.Days["format"]["wide"], "weekday_", weekdays, 0},
+		{greg.Days["format"]["abbreviated"], "weekday_short_", weekdays, 0},
+	}
+	for _, n := range names {
+		for i, key := range n.keys {
+			if val, ok := n.table[key]; ok {
+				loc.dictionary[fmt.Sprintf("%s%d", n.prefix, i+n.first)] = val
+			}
+		}
+	}
+
+	if periods := greg.DayPeriods["format"]["abbreviated"]; periods != nil {
+		for _, p := range []string{"am", "pm"} {
+			if val, ok := periods[p]; ok {
+				loc.dictionary[p] = val
+			}
+		}
+	}
+	for era, val := range greg.Eras["eraAbbr"] {
+		loc.dictionary["era_"+era] = val
+	}
+
+	for style, p := range greg.DateFormats {
+		loc.dictionary["pattern_date_"+style] = p
+	}
+	for style, p := range greg.TimeFormats {
+		loc.dictionary["pattern_time_"+style] = p
+	}
+	if raw, ok := greg.DateTimeFormats["medium"]; ok {
+		var p string
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return fmt.Errorf("dateTimeFormats.medium: %w", err)
+		}
+		loc.dictionary["pattern_datetime"] = p
+	}
+	if raw, ok := greg.DateTimeFormats["availableFormats"]; ok {
+		var available map[string]string
+		if err := json.Unmarshal(raw, &available); err != nil {
+			return fmt.Errorf("availableFormats: %w", err)
+		}
+		for _, skeleton := range availableFormats {
+			if p, ok := available[skeleton]; ok {
+				loc.dictionary["pattern_skeleton_"+skeleton] = p
+			}
+		}
+	}
+	return nil
+}
+
+// loadCalendarNames copies the month and era names of another calendar:
+// "islamic_month_N", "islamic_month_short_N", "islamic_era_N" and, for
+// the narrow era names, "japanese_era_narrow_N" ("R"). Names of
+// leap years ("7-yeartype-leap", Adar II) become "hebrew_month_leap_N".
+func loadCalendarNames(loc *locale, name string, cal calendar) {
+	for width, prefix := range map[string]string{"wide": "_month_", "abbreviated": "_month_short_"} {
+		for key, val := range cal.Months["format"][width] {
+			if month, ok := strings.CutSuffix(key, "-yeartype-leap"); ok {
+				key = "leap_" + month
+			}
+			loc.dictionary[name+prefix+key] = val
+		}
+	}
+	for width, prefix := range map[string]string{"eraAbbr": "_era_", "eraNarrow": "_era_narrow_"} {
+		for key, val := range cal.Eras[width] {
+			loc.dictionary[name+prefix+key] = val
+		}
+	}
+
+	// Lunisolar calendars: "chinese_month_pattern_leap" ("闰{0}"), the
+	// sexagenary year names, which stand in for eras ("chinese_era_40" is
+	// the 41st year, 甲辰), "chinese_zodiac_N" and "chinese_solar_term_N".
+	if leap, ok := cal.MonthPatterns["format"]["wide"]["leap"]; ok {
+		loc.dictionary[name+"_month_pattern_leap"] = leap
+	}
+	for set, prefix := range map[string]string{"years": "_era_", "zodiacs": "_zodiac_", "solarTerms": "_solar_term_"} {
+		for key, val := range cal.CyclicNameSets[set]["format"]["abbreviated"] {
+			if set == "years" {
+				n, err := strconv.Atoi(key)
+				if err != nil {
+					continue
+				}
+				key = strconv.Itoa(n - 1)
+			}
+			loc.dictionary[name+prefix+key] = val
+		}
+	}
+}
+
+func monthKeys() []string {
+	keys := make([]string, 12)
+	for i := range keys {
+		keys[i] = fmt.Sprint(i + 1)
+	}
+	return keys
+}
+
+// byCategory strips the CLDR count prefix from pattern keys: "unitPattern-count-one" -> "one".
+// Patterns without a "{0}" placeholder, like the Arabic dual "قبل ساعتين" (two hours ago),
+// are left out: smart would read them as bare words, so those counts use the "other" pattern.
+func byCategory(patterns map[string]string, prefix string) map[string]string {
+	out := map[string]string{}
+	for key, p := range patterns {
+		if !strings.Contains(p, "{0}") {
+			continue
+		}
+		if cat, ok := strings.CutPrefix(key, prefix); ok {
+			if _, known := categoryConsts[cat]; known {
+				out[cat] = p
+			}
+		}
+	}
+	return out
+}
+
+func render(locales []locale) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by cldrgen from a CLDR JSON snapshot; DO NOT EDIT.\n\n")
+	b.WriteString("package smart\n\n")
+	b.WriteString("var cldrLocales = []Locale{\n")
+	for _, loc := range locales {
+		fmt.Fprintf(&b, "{\nCode: %q,\n", loc.code)
+
+		if len(loc.pluralRules) > 0 {
+			b.WriteString("PluralRules: map[PluralCategory]string{\n")
+			for _, cat := range categoryOrder {
+				if rule, ok := loc.pluralRules[cat]; ok {
+					fmt.Fprintf(&b, "%s: %q,\n", categoryConsts[cat], rule)
+				}
+			}
+			b.WriteString("},\n")
+		}
+
+		b.WriteString("Dictionary: map[string]string{\n")
+		for _, key := range sortedKeys(loc.dictionary) {
+			fmt.Fprintf(&b, "%q: %q,\n", key, loc.dictionary[key])
+		}
+		b.WriteString("},\n")
+
+		if len(loc.plurals) > 0 {
+			b.WriteString("Plurals: map[string]map[PluralCategory]string{\n")
+			for _, unit := range sortedKeys(loc.plurals) {
+				fmt.Fprintf(&b, "%q: %s,\n", unit, renderForms(loc.plurals[unit]))
+			}
+			b.WriteString("},\n")
+		}
+
+		if len(loc.forms) > 0 {
+			b.WriteString("Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{\n")
+			for _, unit := range sortedKeys(loc.forms) {
+				fmt.Fprintf(&b, "%q: {\n", unit)
+				for _, ctx := range sortedKeys(loc.forms[unit]) {
+					fmt.Fprintf(&b, "%s: %s,\n", ctx, renderForms(loc.forms[unit][ctx]))
+				}
+				b.WriteString("},\n")
+			}
+			b.WriteString("},\n")
+		}
+
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func renderForms(forms map[string]string) string {
+	var parts []string
+	for _, cat := range categoryOrder {
+		if p, ok := forms[cat]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %q", categoryConsts[cat], p))
+		}
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails when cldr_data.go no longer matches the checked-in snapshot.
+func TestGeneratedUpToDate(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "cldr_data.go")
+	cmd := exec.Command("go", "run", ".", "-src", "../../cldr", "-out", out)
+	if msg, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("cldrgen failed: %v\n%s", err, msg)
+	}
+
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("../../cldr_data.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("cldr_data.go is stale; run `go generate ./timestamp/smart`")
+	}
+}
@@ -1,26 +1,127 @@
 package smart
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Roisfaozi/unik/timestamp/util"
+)
+
 // PluralCategory constants based on CLDR (Common Locale Data Repository)
 type PluralCategory int
 
 const (
 	PluralOther PluralCategory = iota
 	PluralOne
-	// Can be extended: PluralZero, PluralTwo, PluralFew, PluralMany
+	PluralZero
+	PluralTwo
+	PluralFew
+	PluralMany
 )
 
 // PluralRuleFunc defines how to map a number to a category
 type PluralRuleFunc func(n int) PluralCategory
 
+// GrammaticalContext describes the sentence slot a unit word is rendered in.
+// Languages with case inflection need a different form for "vor 2 Tagen"
+// (dative, relative past) than for a bare duration "2 Tage" (nominative).
+type GrammaticalContext int
+
+const (
+	ContextStandalone GrammaticalContext = iota // Durations: "2 Tage"
+	ContextPast                                 // Relative past: "vor 2 Tagen"
+	ContextFuture                               // Relative future: "in 2 Tagen"
+)
+
+var contextNames = map[GrammaticalContext]string{
+	ContextStandalone: "standalone",
+	ContextPast:       "past",
+	ContextFuture:     "future",
+}
+
+// String returns the lowercase name of the context ("standalone", "past", "future").
+func (c GrammaticalContext) String() string {
+	if name, ok := contextNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("GrammaticalContext(%d)", int(c))
+}
+
+// ParseGrammaticalContext is the inverse of GrammaticalContext.String.
+func ParseGrammaticalContext(name string) (GrammaticalContext, error) {
+	for ctx, n := range contextNames {
+		if n == name {
+			return ctx, nil
+		}
+	}
+	return ContextStandalone, fmt.Errorf("unknown grammatical context: %q", name)
+}
+
+// Register is the formality level of the wording (e.g. casual chat vs. banking).
+type Register int
+
+const (
+	RegisterNeutral Register = iota // Default wording: "5 menit lalu"
+	RegisterCasual                  // Chat-style wording: "5 mnt lalu"
+	RegisterFormal                  // Formal wording: "5 menit yang lalu"
+)
+
+var registerNames = map[Register]string{
+	RegisterNeutral: "neutral",
+	RegisterCasual:  "casual",
+	RegisterFormal:  "formal",
+}
+
+// String returns the lowercase name of the register ("neutral", "casual", "formal").
+func (r Register) String() string {
+	if name, ok := registerNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Register(%d)", int(r))
+}
+
+// ParseRegister is the inverse of Register.String.
+func ParseRegister(name string) (Register, error) {
+	for reg, n := range registerNames {
+		if n == name {
+			return reg, nil
+		}
+	}
+	return RegisterNeutral, fmt.Errorf("unknown register: %q", name)
+}
+
+// LocaleVariant overrides part of a Locale for a specific Register.
+// Missing entries fall back to the neutral Locale data.
+type LocaleVariant struct {
+	Dictionary map[string]string
+	Plurals    map[string]map[PluralCategory]string
+}
+
 // Locale defines the localization data for a language
 type Locale struct {
 	Code       string
 	PluralRule PluralRuleFunc
-	Dictionary map[string]string              // For static fixed words (e.g. "just_now")
-	Plurals    map[string]map[PluralCategory]string // For words that change with number (e.g. "minute")
+	// PluralRules is the CLDR source of PluralRule (e.g. {PluralOne: "i = 1 and v = 0"}).
+	// It is compiled with CompilePluralRules when PluralRule is nil.
+	PluralRules map[PluralCategory]string
+	Dictionary  map[string]string                    // For static fixed words (e.g. "just_now")
+	Plurals     map[string]map[PluralCategory]string // For words that change with number (e.g. "minute")
+	// Forms overrides Plurals for a specific grammatical context
+	// (e.g. German dative "Tagen" after "vor"). Missing entries fall back to Plurals.
+	// In both tables a form is either a bare word ("Minuten") or a full phrase
+	// with a "{0}" placeholder for the number ("vor {0} Minuten"), as generated from CLDR.
+	Forms map[string]map[GrammaticalContext]map[PluralCategory]string
+	// Registers holds casual/formal overrides; RegisterNeutral is the Locale itself.
+	Registers map[Register]LocaleVariant
 }
 
-var registry = map[string]Locale{}
+var (
+	registry     = map[string]Locale{}
+	registryLock sync.RWMutex
+)
 
 func init() {
 	registerID()
@@ -29,6 +130,10 @@ func init() {
 	registerVN()
 	registerJP()
 	registerMY()
+	registerDE()
+	registerHI()
+	registerCLDR()   // Fills gaps from the generated CLDR tables
+	registerPseudo() // Derived from EN, keep last
 }
 
 func registerEN() {
@@ -42,13 +147,76 @@ func registerEN() {
 		},
 		Dictionary: map[string]string{
 			"just_now": "just now",
-			"ago":      "ago",
+			"past":     "{0} ago",
+			"future":   "in {0}",
+			"ago":      "ago", // The words of "past" and "future", kept for GetTrans callers
 			"in":       "in",
 			"s":        "s", // Short forms usually don't pluralize in this context (1s, 2s)
 			"m":        "m",
 			"h":        "h",
 			"d":        "d",
 			"y":        "y",
+
+			// Calendar names used by Adaptive
+			"weekday_0":      "Sunday",
+			"weekday_1":      "Monday",
+			"weekday_2":      "Tuesday",
+			"weekday_3":      "Wednesday",
+			"weekday_4":      "Thursday",
+			"weekday_5":      "Friday",
+			"weekday_6":      "Saturday",
+			"month_short_1":  "Jan",
+			"month_short_2":  "Feb",
+			"month_short_3":  "Mar",
+			"month_short_4":  "Apr",
+			"month_short_5":  "May",
+			"month_short_6":  "Jun",
+			"month_short_7":  "Jul",
+			"month_short_8":  "Aug",
+			"month_short_9":  "Sep",
+			"month_short_10": "Oct",
+			"month_short_11": "Nov",
+			"month_short_12": "Dec",
+
+			// Javanese calendar, written the same in every language (CLDR has none)
+			"javanese_month_1":   "Sura",
+			"javanese_month_2":   "Sapar",
+			"javanese_month_3":   "Mulud",
+			"javanese_month_4":   "Bakda Mulud",
+			"javanese_month_5":   "Jumadilawal",
+			"javanese_month_6":   "Jumadilakir",
+			"javanese_month_7":   "Rejeb",
+			"javanese_month_8":   "Ruwah",
+			"javanese_month_9":   "Pasa",
+			"javanese_month_10":  "Sawal",
+			"javanese_month_11":  "Sela",
+			"javanese_month_12":  "Besar",
+			"javanese_era_0":     "AJ",
+			"javanese_pasaran_0": "Legi",
+			"javanese_pasaran_1": "Pahing",
+			"javanese_pasaran_2": "Pon",
+			"javanese_pasaran_3": "Wage",
+			"javanese_pasaran_4": "Kliwon",
+			"javanese_year_1":    "Alip",
+			"javanese_year_2":    "Ehe",
+			"javanese_year_3":    "Jimawal",
+			"javanese_year_4":    "Je",
+			"javanese_year_5":    "Dal",
+			"javanese_year_6":    "Be",
+			"javanese_year_7":    "Wawu",
+			"javanese_year_8":    "Jimakir",
+
+			// Vikram Samvat; its months are the Saka ("indian") months of CLDR
+			"vikram_era_0":              "VS",
+			"vikram_month_pattern_leap": "Adhik {0}",
+			"vikram_paksha_0":           "Shukla",
+			"vikram_paksha_1":           "Krishna",
+
+			// CLDR leaves the Ethiopic and Coptic eras as "ERA0" and "ERA1" in English
+			"ethiopic_era_0": "AA", // Amete Alem
+			"ethiopic_era_1": "EC", // Ethiopian Calendar (Amete Mihret)
+			"coptic_era_0":   "BD", // Before Diocletian
+			"coptic_era_1":   "AM", // Anno Martyrum
 		},
 		Plurals: map[string]map[PluralCategory]string{
 			"sec":  {PluralOne: "second", PluralOther: "seconds"},
@@ -70,6 +238,8 @@ func registerID() {
 		},
 		Dictionary: map[string]string{
 			"just_now": "baru saja",
+			"past":     "{0} lalu",
+			"future":   "dalam {0}",
 			"ago":      "lalu",
 			"in":       "dalam",
 			"s":        "dtk",
@@ -77,6 +247,20 @@ func registerID() {
 			"h":        "j",
 			"d":        "h",
 			"y":        "thn",
+
+			// Hijri months as written in Indonesia (CLDR: "Jumada II")
+			"islamic_month_1":  "Muharram",
+			"islamic_month_2":  "Safar",
+			"islamic_month_3":  "Rabiul Awal",
+			"islamic_month_4":  "Rabiul Akhir",
+			"islamic_month_5":  "Jumadil Awal",
+			"islamic_month_6":  "Jumadil Akhir",
+			"islamic_month_7":  "Rajab",
+			"islamic_month_8":  "Syakban",
+			"islamic_month_9":  "Ramadan",
+			"islamic_month_10": "Syawal",
+			"islamic_month_11": "Zulkaidah",
+			"islamic_month_12": "Zulhijah",
 		},
 		Plurals: map[string]map[PluralCategory]string{
 			"sec":  {PluralOther: "detik"},
@@ -85,6 +269,23 @@ func registerID() {
 			"day":  {PluralOther: "hari"},
 			"year": {PluralOther: "tahun"},
 		},
+		Registers: map[Register]LocaleVariant{
+			RegisterCasual: {
+				Dictionary: map[string]string{
+					"just_now": "barusan",
+				},
+				Plurals: map[string]map[PluralCategory]string{
+					"sec":  {PluralOther: "dtk"},
+					"min":  {PluralOther: "mnt"},
+					"year": {PluralOther: "thn"},
+				},
+			},
+			RegisterFormal: {
+				Dictionary: map[string]string{
+					"past": "{0} yang lalu",
+				},
+			},
+		},
 	}
 }
 
@@ -96,20 +297,22 @@ func registerTH() {
 		},
 		Dictionary: map[string]string{
 			"just_now": "เมื่อสักครู่", // Muea sak khru
-			"ago":      "ที่แล้ว",      // Tee laeo
-			"in":       "อีก",          // Eek
-			"s":        "วิ",           // Short Wi
-			"m":        "น.",           // Short N.
-			"h":        "ชม.",          // Short Chom.
-			"d":        "วัน",          // Short Wan
-			"y":        "ปี",           // Short Pee
+			"past":     "{0} ที่แล้ว",  // Tee laeo
+			"future":   "อีก {0}",      // Eek
+			"ago":      "ที่แล้ว",
+			"in":       "อีก",
+			"s":        "วิ",  // Short Wi
+			"m":        "น.",  // Short N.
+			"h":        "ชม.", // Short Chom.
+			"d":        "วัน", // Short Wan
+			"y":        "ปี",  // Short Pee
 		},
 		Plurals: map[string]map[PluralCategory]string{
-			"sec":  {PluralOther: "วินาที"}, // Winathi
-			"min":  {PluralOther: "นาที"},   // Nathi
+			"sec":  {PluralOther: "วินาที"},  // Winathi
+			"min":  {PluralOther: "นาที"},    // Nathi
 			"hour": {PluralOther: "ชั่วโมง"}, // Chua mong
-			"day":  {PluralOther: "วัน"},    // Wan
-			"year": {PluralOther: "ปี"},     // Pee
+			"day":  {PluralOther: "วัน"},     // Wan
+			"year": {PluralOther: "ปี"},      // Pee
 		},
 	}
 }
@@ -122,6 +325,8 @@ func registerVN() {
 		},
 		Dictionary: map[string]string{
 			"just_now": "vừa xong",
+			"past":     "{0} trước",
+			"future":   "trong {0}",
 			"ago":      "trước",
 			"in":       "trong",
 			"s":        "giây",
@@ -147,14 +352,18 @@ func registerJP() {
 			return PluralOther
 		},
 		Dictionary: map[string]string{
-			"just_now": "たった今", // Tatta ima
-			"ago":      "前",    // Mae
-			"in":       "後",    // Go (After/In context)
-			"s":        "秒",    // Byo
-			"m":        "分",    // Fun
-			"h":        "時間",   // Jikan
-			"d":        "日",    // Nichi
-			"y":        "年",    // Nen
+			"just_now": "たった今",  // Tatta ima
+			"past":     "{0} 前", // Mae
+			"future":   "{0} 後", // Go (After/In context)
+			"ago":      "前",
+			"in":       "後",
+			"s":        "秒",  // Byo
+			"m":        "分",  // Fun
+			"h":        "時間", // Jikan
+			"d":        "日",  // Nichi
+			"y":        "年",  // Nen
+
+			"japanese_gannen": "元", // Gannen, the first year of an era (令和元年)
 		},
 		Plurals: map[string]map[PluralCategory]string{
 			"sec":  {PluralOther: "秒"},
@@ -163,6 +372,20 @@ func registerJP() {
 			"day":  {PluralOther: "日"}, // Or Nichi-kan for duration? Usually just Nichi + Mae usually suffices
 			"year": {PluralOther: "年"},
 		},
+		Registers: map[Register]LocaleVariant{
+			RegisterCasual: {
+				Dictionary: map[string]string{
+					"just_now": "さっき",    // Sakki (plain)
+					"past":     "{0}前",   // No space, as in chat
+					"future":   "あと {0}", // Ato (plain: "5 more minutes")
+				},
+			},
+			RegisterFormal: {
+				Dictionary: map[string]string{
+					"just_now": "先ほど", // Sakihodo (polite); the neutral "{0} 前" suits formal text
+				},
+			},
+		},
 	}
 }
 
@@ -175,7 +398,9 @@ func registerMY() {
 		},
 		Dictionary: map[string]string{
 			"just_now": "baru saja",
-			"ago":      "lepas",       // 5 minit lepas (vs lalu)
+			"past":     "{0} lepas", // 5 minit lepas (vs lalu)
+			"future":   "dalam {0}",
+			"ago":      "lepas",
 			"in":       "dalam",
 			"s":        "saat",
 			"m":        "minit",
@@ -193,48 +418,267 @@ func registerMY() {
 	}
 }
 
+func registerDE() {
+	registry["de"] = Locale{
+		Code: "de",
+		PluralRule: func(n int) PluralCategory {
+			if n == 1 {
+				return PluralOne
+			}
+			return PluralOther
+		},
+		Dictionary: map[string]string{
+			"just_now": "gerade eben",
+			"past":     "vor {0}", // "vor" governs the dative
+			"future":   "in {0}",
+			"ago":      "vor",
+			"in":       "in",
+			"s":        "s",
+			"m":        "min",
+			"h":        "h",
+			"d":        "T",
+			"y":        "J",
+		},
+		Plurals: map[string]map[PluralCategory]string{
+			"sec":  {PluralOne: "Sekunde", PluralOther: "Sekunden"},
+			"min":  {PluralOne: "Minute", PluralOther: "Minuten"},
+			"hour": {PluralOne: "Stunde", PluralOther: "Stunden"},
+			"day":  {PluralOne: "Tag", PluralOther: "Tage"},
+			"year": {PluralOne: "Jahr", PluralOther: "Jahre"},
+		},
+		// Dative plural adds -n to nouns whose nominative plural doesn't end in -n.
+		Forms: map[string]map[GrammaticalContext]map[PluralCategory]string{
+			"day": {
+				ContextPast:   {PluralOther: "Tagen"},
+				ContextFuture: {PluralOther: "Tagen"},
+			},
+			"year": {
+				ContextPast:   {PluralOther: "Jahren"},
+				ContextFuture: {PluralOther: "Jahren"},
+			},
+		},
+	}
+}
+
+// registerHI only adds the Vikram Samvat names; the rest of Hindi comes from
+// CLDR.
+func registerHI() {
+	registry["hi"] = Locale{
+		Code: "hi",
+		Dictionary: map[string]string{
+			"vikram_era_0":              "वि॰ सं॰",
+			"vikram_month_pattern_leap": "अधिक {0}",
+			"vikram_paksha_0":           "शुक्ल",
+			"vikram_paksha_1":           "कृष्ण",
+		},
+	}
+}
+
+// registerPseudo registers the pseudo-locale (util.PseudoLocale) by running every
+// EN string through util.Pseudolocalize. Output that is not accented and
+// bracketed when formatting with it was never translated.
+func registerPseudo() {
+	en := registry["en"]
+	pseudo := Locale{
+		Code:        util.PseudoLocale,
+		PluralRule:  en.PluralRule,
+		PluralRules: en.PluralRules,
+		Dictionary:  map[string]string{},
+		Plurals:     map[string]map[PluralCategory]string{},
+	}
+	for key, val := range en.Dictionary {
+		if isPatternKey(key) {
+			pseudo.Dictionary[key] = val // LDML patterns are not user-visible text
+			continue
+		}
+		pseudo.Dictionary[key] = util.Pseudolocalize(val)
+	}
+	for key, forms := range en.Plurals {
+		pseudo.Plurals[key] = map[PluralCategory]string{}
+		for cat, val := range forms {
+			pseudo.Plurals[key][cat] = util.Pseudolocalize(val)
+		}
+	}
+	registry[pseudo.Code] = pseudo
+}
+
+// isPatternKey reports whether a dictionary key holds an LDML date/time pattern
+// (e.g. "pattern_date_long") rather than translated text.
+func isPatternKey(key string) bool {
+	return strings.HasPrefix(key, "pattern_")
+}
+
+// category maps count to its plural category, treating a missing rule as "other only".
+func (l Locale) category(count int) PluralCategory {
+	if l.PluralRule == nil {
+		return PluralOther
+	}
+	return l.PluralRule(count)
+}
+
+// weekdayKey returns the dictionary key of a weekday name (e.g. "weekday_1" for Monday).
+func weekdayKey(d time.Weekday) string {
+	return fmt.Sprintf("weekday_%d", d)
+}
+
+// monthShortKey returns the dictionary key of an abbreviated month name (e.g. "month_short_12").
+func monthShortKey(m time.Month) string {
+	return fmt.Sprintf("month_short_%d", m)
+}
+
+// RegisterLocale adds loc to the registry, replacing any locale with the same code.
+// If loc.PluralRule is nil it is compiled from loc.PluralRules; a locale with
+// neither uses PluralOther for every count. It is safe for concurrent use.
+//
+// Example:
+//
+//	err := RegisterLocale(Locale{
+//		Code:        "pl",
+//		PluralRules: map[PluralCategory]string{PluralOne: "i = 1 and v = 0"},
+//		Dictionary:  map[string]string{"just_now": "teraz", "past": "{0} temu"},
+//		Plurals:     map[string]map[PluralCategory]string{"min": {PluralOne: "minuta", PluralOther: "minut"}},
+//	})
+func RegisterLocale(loc Locale) error {
+	if loc.Code == "" {
+		return fmt.Errorf("locale code is required")
+	}
+	if loc.PluralRule == nil && loc.PluralRules != nil {
+		rule, err := CompilePluralRules(loc.PluralRules)
+		if err != nil {
+			return fmt.Errorf("locale %s: %w", loc.Code, err)
+		}
+		loc.PluralRule = rule
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[loc.Code] = loc
+	return nil
+}
+
+// UnregisterLocale removes the locale registered for code, so lookups fall
+// back to its parent language, or to English. It is safe for concurrent use.
+func UnregisterLocale(code string) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	delete(registry, code)
+}
+
+// LookupLocale returns the registered locale for code.
+func LookupLocale(code string) (Locale, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	loc, ok := registry[code]
+	return loc, ok
+}
+
+// Locales returns every registered locale, sorted by code.
+func Locales() []Locale {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	locales := make([]Locale, 0, len(registry))
+	for _, loc := range registry {
+		locales = append(locales, loc)
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i].Code < locales[j].Code })
+	return locales
+}
+
 // GetTrans retrieves a static translation.
 func GetTrans(lang, key string) string {
-	loc, ok := registry[lang]
-	if !ok {
-		loc = registry["en"] // Fallback to EN
-	}
-	
-	if val, ok := loc.Dictionary[key]; ok {
-		return val
+	return getTrans(lang, key, RegisterNeutral)
+}
+
+func getTrans(lang, key string, reg Register) string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	for _, loc := range lookupChain(lang) {
+		if val, ok := loc.Registers[reg].Dictionary[key]; ok {
+			return val
+		}
+		if val, ok := loc.Dictionary[key]; ok {
+			return val
+		}
 	}
-	
+
 	// Fallback to EN dictionary if key missing in target lang
 	if fallbackVal, ok := registry["en"].Dictionary[key]; ok {
 		return fallbackVal
 	}
-	
+
 	return key // Return key if absolutely nothing found
 }
 
+// lookupChain returns the registered locales for lang and its parent languages,
+// most specific first ("de-AT" -> de-AT, de), or the EN locale if none is
+// registered. Regional locales such as "en-150" only carry what differs from
+// their parent. The caller must hold registryLock.
+func lookupChain(lang string) []Locale {
+	var chain []Locale
+	for code := lang; code != ""; {
+		if loc, ok := registry[code]; ok {
+			chain = append(chain, loc)
+		}
+		i := strings.LastIndex(code, "-")
+		if i < 0 {
+			break
+		}
+		code = code[:i]
+	}
+	if len(chain) == 0 {
+		chain = append(chain, registry["en"])
+	}
+	return chain
+}
+
 // GetPlural retrieves a word form based on count.
 func GetPlural(lang, key string, count int) string {
-	loc, ok := registry[lang]
-	if !ok {
-		loc = registry["en"]
-	}
+	return GetPluralForm(lang, key, count, ContextStandalone)
+}
 
-	category := loc.PluralRule(count)
-	
-	// Try finding the specific plural form
-	if forms, ok := loc.Plurals[key]; ok {
-		if val, ok := forms[category]; ok {
-			return val
+// GetPluralForm retrieves a word form based on count and grammatical context.
+// Context-specific forms take precedence over the standalone Plurals table.
+//
+// Example:
+//
+//	GetPluralForm("de", "day", 2, ContextStandalone) // "Tage"
+//	GetPluralForm("de", "day", 2, ContextPast)       // "Tagen"
+func GetPluralForm(lang, key string, count int, ctx GrammaticalContext) string {
+	return getPluralForm(lang, key, count, ctx, RegisterNeutral)
+}
+
+func getPluralForm(lang, key string, count int, ctx GrammaticalContext, reg Register) string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	// Try the exact category first (register variant, then context, then the
+	// standalone table), then fall back to Other in the same order. Where the
+	// context has forms, standalone phrases such as the Hebrew dual "{0} דקות"
+	// are skipped, so that count takes the context's Other ("לפני {0} דקות");
+	// bare words such as the German "Tag" still combine with "vor {0}".
+	for _, loc := range lookupChain(lang) {
+		contextForms := loc.Forms[key][ctx]
+		tables := []map[PluralCategory]string{
+			loc.Registers[reg].Plurals[key],
+			contextForms,
+			loc.Plurals[key],
 		}
-		// Fallback to Other if specific category missing
-		if val, ok := forms[PluralOther]; ok {
-			return val
+		for _, category := range []PluralCategory{loc.category(count), PluralOther} {
+			for i, forms := range tables {
+				val, ok := forms[category]
+				if !ok || i == len(tables)-1 && len(contextForms) > 0 && isPhrasePattern(val) {
+					continue
+				}
+				return val
+			}
 		}
 	}
 
 	// Fallback to EN logic
 	enLoc := registry["en"]
-	enCategory := enLoc.PluralRule(count)
+	enCategory := enLoc.category(count)
 	if forms, ok := enLoc.Plurals[key]; ok {
 		if val, ok := forms[enCategory]; ok {
 			return val
@@ -0,0 +1,213 @@
+package smart
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var pluralCategoryNames = map[PluralCategory]string{
+	PluralOther: "other",
+	PluralOne:   "one",
+	PluralZero:  "zero",
+	PluralTwo:   "two",
+	PluralFew:   "few",
+	PluralMany:  "many",
+}
+
+// String returns the CLDR name of the category ("one", "few", ...).
+func (c PluralCategory) String() string {
+	if name, ok := pluralCategoryNames[c]; ok {
+		return name
+	}
+	return "PluralCategory(" + strconv.Itoa(int(c)) + ")"
+}
+
+// ParsePluralCategory converts a CLDR category name ("one", "few", ...) to a PluralCategory.
+func ParsePluralCategory(name string) (PluralCategory, error) {
+	for cat, n := range pluralCategoryNames {
+		if n == name {
+			return cat, nil
+		}
+	}
+	return PluralOther, fmt.Errorf("unknown plural category: %q", name)
+}
+
+// pluralEvalOrder is the order in which CLDR rules are tested; "other" is implicit.
+var pluralEvalOrder = []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany}
+
+// CompilePluralRules builds a PluralRuleFunc from CLDR plural rule syntax,
+// e.g. {PluralOne: "i = 1 and v = 0"}. Sample lists ("@integer ...") are ignored.
+// Counts are always integers here, so the operands v, w, f, t, c and e are 0
+// and n equals i.
+//
+// Example:
+//
+//	rule, _ := CompilePluralRules(map[PluralCategory]string{
+//		PluralOne: "v = 0 and i % 10 = 1 and i % 100 != 11",
+//		PluralFew: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+//	})
+//	rule(22) // PluralFew
+func CompilePluralRules(rules map[PluralCategory]string) (PluralRuleFunc, error) {
+	type compiled struct {
+		category  PluralCategory
+		condition pluralCondition
+	}
+	var conditions []compiled
+	for _, cat := range pluralEvalOrder {
+		src, ok := rules[cat]
+		if !ok {
+			continue
+		}
+		cond, err := parsePluralCondition(src)
+		if err != nil {
+			return nil, fmt.Errorf("plural rule %s: %w", cat, err)
+		}
+		if cond != nil {
+			conditions = append(conditions, compiled{cat, cond})
+		}
+	}
+
+	return func(n int) PluralCategory {
+		if n < 0 {
+			n = -n
+		}
+		for _, c := range conditions {
+			if c.condition(n) {
+				return c.category
+			}
+		}
+		return PluralOther
+	}, nil
+}
+
+// pluralCondition reports whether an integer count satisfies a rule.
+type pluralCondition func(n int) bool
+
+// parsePluralCondition parses "condition = and_condition ('or' and_condition)*".
+// An empty condition (the usual "other" rule) yields nil.
+func parsePluralCondition(src string) (pluralCondition, error) {
+	if i := strings.Index(src, "@"); i >= 0 {
+		src = src[:i]
+	}
+	src = strings.TrimSpace(src)
+	if src == "" {
+		return nil, nil
+	}
+
+	var alternatives [][]pluralCondition
+	for _, orPart := range strings.Split(src, " or ") {
+		var all []pluralCondition
+		for _, andPart := range strings.Split(orPart, " and ") {
+			rel, err := parsePluralRelation(strings.TrimSpace(andPart))
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, rel)
+		}
+		alternatives = append(alternatives, all)
+	}
+
+	return func(n int) bool {
+		for _, all := range alternatives {
+			ok := true
+			for _, rel := range all {
+				if !rel(n) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// parsePluralRelation parses a single relation such as "i % 100 != 12..14",
+// "n is not 0" or "n within 0..2".
+func parsePluralRelation(src string) (pluralCondition, error) {
+	fields := strings.Fields(src)
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("invalid relation %q", src)
+	}
+
+	operand := fields[0]
+	switch operand {
+	case "n", "i", "v", "w", "f", "t", "c", "e":
+	default:
+		return nil, fmt.Errorf("unknown operand %q in %q", operand, src)
+	}
+	fields = fields[1:]
+
+	mod := 0
+	if fields[0] == "%" || fields[0] == "mod" {
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("invalid modulus in %q", src)
+		}
+		m, err := strconv.Atoi(fields[1])
+		if err != nil || m == 0 {
+			return nil, fmt.Errorf("invalid modulus in %q", src)
+		}
+		mod = m
+		fields = fields[2:]
+	}
+
+	negate := false
+	switch {
+	case len(fields) >= 2 && fields[0] == "is" && fields[1] == "not":
+		negate, fields = true, fields[2:]
+	case len(fields) >= 2 && fields[0] == "not" && (fields[1] == "in" || fields[1] == "within"):
+		negate, fields = true, fields[2:]
+	case len(fields) >= 1 && (fields[0] == "=" || fields[0] == "is" || fields[0] == "in" || fields[0] == "within"):
+		fields = fields[1:]
+	case len(fields) >= 1 && fields[0] == "!=":
+		negate, fields = true, fields[1:]
+	default:
+		return nil, fmt.Errorf("invalid operator in %q", src)
+	}
+
+	ranges, err := parsePluralRanges(strings.Join(fields, ""))
+	if err != nil {
+		return nil, fmt.Errorf("%w in %q", err, src)
+	}
+
+	return func(n int) bool {
+		v := n
+		if operand != "n" && operand != "i" {
+			v = 0 // Fraction digits and exponent are always 0 for integer counts
+		}
+		if mod != 0 {
+			v %= mod
+		}
+		in := false
+		for _, r := range ranges {
+			if v >= r[0] && v <= r[1] {
+				in = true
+				break
+			}
+		}
+		return in != negate
+	}, nil
+}
+
+// parsePluralRanges parses a range list such as "2..4,22,32".
+func parsePluralRanges(src string) ([][2]int, error) {
+	var ranges [][2]int
+	for _, part := range strings.Split(src, ",") {
+		lo, hi, isRange := strings.Cut(part, "..")
+		from, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", part)
+		}
+		to := from
+		if isRange {
+			if to, err = strconv.Atoi(hi); err != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+		}
+		ranges = append(ranges, [2]int{from, to})
+	}
+	return ranges, nil
+}
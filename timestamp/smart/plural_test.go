@@ -0,0 +1,54 @@
+package smart
+
+import "testing"
+
+func TestCompilePluralRules(t *testing.T) {
+	ru, err := CompilePluralRules(map[PluralCategory]string{
+		PluralOne:  "v = 0 and i % 10 = 1 and i % 100 != 11 @integer 1, 21, 31",
+		PluralFew:  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+		PluralMany: "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+	})
+	if err != nil {
+		t.Fatalf("CompilePluralRules(ru) error = %v", err)
+	}
+
+	tests := []struct {
+		n        int
+		expected PluralCategory
+	}{
+		{1, PluralOne}, {21, PluralOne}, {11, PluralMany}, {2, PluralFew}, {24, PluralFew},
+		{12, PluralMany}, {5, PluralMany}, {0, PluralMany}, {111, PluralMany}, {101, PluralOne},
+	}
+	for _, tt := range tests {
+		if got := ru(tt.n); got != tt.expected {
+			t.Errorf("ru(%d) = %v, want %v", tt.n, got, tt.expected)
+		}
+	}
+
+	fr, err := CompilePluralRules(map[PluralCategory]string{
+		PluralOne:  "i = 0,1",
+		PluralMany: "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5",
+	})
+	if err != nil {
+		t.Fatalf("CompilePluralRules(fr) error = %v", err)
+	}
+	if fr(0) != PluralOne || fr(1) != PluralOne || fr(2) != PluralOther || fr(2000000) != PluralMany {
+		t.Errorf("fr rules: got %v %v %v %v", fr(0), fr(1), fr(2), fr(2000000))
+	}
+
+	if _, err := CompilePluralRules(map[PluralCategory]string{PluralOne: "x = 1"}); err == nil {
+		t.Error("expected error for unknown operand")
+	}
+}
+
+func TestParsePluralCategory(t *testing.T) {
+	for _, cat := range []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther} {
+		got, err := ParsePluralCategory(cat.String())
+		if err != nil || got != cat {
+			t.Errorf("ParsePluralCategory(%q) = %v, %v", cat.String(), got, err)
+		}
+	}
+	if _, err := ParsePluralCategory("several"); err == nil {
+		t.Error("expected error for unknown category")
+	}
+}
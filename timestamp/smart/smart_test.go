@@ -22,6 +22,12 @@ func TestSocial(t *testing.T) {
 		{"5 mins ago ID", -5 * time.Minute, "id", StyleStandard, "5 menit lalu"},
 		{"2 hours ago", -2 * time.Hour, "en", StyleStandard, "2 hours ago"},
 		{"In 5 mins", 5*time.Minute + 2*time.Second, "en", StyleStandard, "in 5 minutes"},
+		{"5 mins ago DE", -5 * time.Minute, "de", StyleStandard, "vor 5 Minuten"},
+		{"1 day ago DE", -25 * time.Hour, "de", StyleStandard, "vor 1 Tag"},
+		{"2 days ago DE (dative)", -49 * time.Hour, "de", StyleStandard, "vor 2 Tagen"},
+		{"In 2 years DE (dative)", 2*365*24*time.Hour + time.Hour, "de", StyleStandard, "in 2 Jahren"},
+		{"Regional variant falls back to DE", -49 * time.Hour, "de-AT", StyleStandard, "vor 2 Tagen"},
+		{"Sparse regional locale falls back to EN", -5 * time.Minute, "en-150", StyleStandard, "5 minutes ago"},
 	}
 
 	for _, tt := range tests {
@@ -35,6 +41,162 @@ func TestSocial(t *testing.T) {
 	}
 }
 
+// TestGetTrans_Words checks the words that the "past" and "future" patterns
+// replaced, which GetTrans still returns.
+func TestGetTrans_Words(t *testing.T) {
+	tests := []struct {
+		lang, key, expected string
+	}{
+		{"en", "ago", "ago"},
+		{"en", "in", "in"},
+		{"id", "ago", "lalu"},
+		{"ms", "ago", "lepas"},
+		{"ja", "in", "後"},
+	}
+
+	for _, tt := range tests {
+		if got := GetTrans(tt.lang, tt.key); got != tt.expected {
+			t.Errorf("GetTrans(%q, %q) = %q, want %q", tt.lang, tt.key, got, tt.expected)
+		}
+	}
+}
+
+func TestGetPluralForm(t *testing.T) {
+	tests := []struct {
+		lang     string
+		key      string
+		count    int
+		ctx      GrammaticalContext
+		expected string
+	}{
+		{"de", "day", 2, ContextStandalone, "Tage"},
+		{"de", "day", 2, ContextPast, "Tagen"},
+		{"de", "day", 1, ContextPast, "Tag"},
+		{"de", "min", 5, ContextFuture, "Minuten"},
+		{"en", "day", 2, ContextPast, "days"}, // No context forms: falls back to Plurals
+		{"id", "day", 2, ContextPast, "hari"},
+		{"he", "min", 2, ContextPast, "לפני {0} דקות"}, // The dual has no past form of its own
+		{"he", "min", 2, ContextStandalone, "{0} דקות"},
+		{"ar", "hour", 2, ContextPast, "قبل {0} ساعة"},
+	}
+
+	for _, tt := range tests {
+		got := GetPluralForm(tt.lang, tt.key, tt.count, tt.ctx)
+		if got != tt.expected {
+			t.Errorf("GetPluralForm(%s, %s, %d, %d) = %v, want %v", tt.lang, tt.key, tt.count, tt.ctx, got, tt.expected)
+		}
+	}
+
+	if got := Social(time.Now().Add(-2*time.Minute-time.Second), "he", StyleStandard); got != "לפני 2 דקות" {
+		t.Errorf("Social(he, 2 minutes ago) = %v, want 'לפני 2 דקות'", got)
+	}
+
+	if got := Duration(2*time.Hour+20*time.Minute, "de"); got != "2 Stunden 20 Minuten" {
+		t.Errorf("Duration(de) = %v, want '2 Stunden 20 Minuten'", got)
+	}
+}
+
+// catalogTranslator simulates an application message catalog that only knows
+// a few keys and defers everything else to the built-in registry.
+type catalogTranslator struct {
+	messages map[string]string
+}
+
+func (c catalogTranslator) Trans(lang, key string) string {
+	if msg, ok := c.messages[lang+"."+key]; ok {
+		return msg
+	}
+	return RegistryTranslator{}.Trans(lang, key)
+}
+
+func (c catalogTranslator) Plural(lang, key string, count int, ctx GrammaticalContext) string {
+	if msg, ok := c.messages[lang+"."+key]; ok {
+		return msg
+	}
+	return RegistryTranslator{}.Plural(lang, key, count, ctx)
+}
+
+func TestFormatter_Translator(t *testing.T) {
+	f := Formatter{Translator: catalogTranslator{messages: map[string]string{
+		"es.just_now": "ahora mismo",
+		"es.past":     "hace {0}",
+		"es.min":      "min",
+	}}}
+	now := time.Now()
+
+	if got := f.Social(now.Add(-5*time.Second), "es", StyleStandard); got != "ahora mismo" {
+		t.Errorf("Social(just now) = %v, want 'ahora mismo'", got)
+	}
+	if got := f.Social(now.Add(-5*time.Minute), "es", StyleStandard); got != "hace 5 min" {
+		t.Errorf("Social(5m) = %v, want 'hace 5 min'", got)
+	}
+	if got := f.Duration(90*time.Second, "es"); got != "1 min 30 segundos" {
+		t.Errorf("Duration() = %v, want '1 min 30 segundos'", got)
+	}
+
+	// Zero value behaves like the package-level functions
+	if got := (Formatter{}).Social(now.Add(-5*time.Minute), "id", StyleStandard); got != "5 menit lalu" {
+		t.Errorf("Formatter{}.Social() = %v, want '5 menit lalu'", got)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	fiveMinsAgo := time.Now().Add(-5 * time.Minute)
+	justNow := time.Now().Add(-5 * time.Second)
+
+	tests := []struct {
+		name     string
+		reg      Register
+		lang     string
+		t        time.Time
+		expected string
+	}{
+		{"ID Casual", RegisterCasual, "id", fiveMinsAgo, "5 mnt lalu"},
+		{"ID Casual just now", RegisterCasual, "id", justNow, "barusan"},
+		{"ID Neutral", RegisterNeutral, "id", fiveMinsAgo, "5 menit lalu"},
+		{"ID Formal", RegisterFormal, "id", fiveMinsAgo, "5 menit yang lalu"},
+		{"ID Formal just now", RegisterFormal, "id", justNow, "baru saja"}, // Falls back to neutral
+		{"JA Formal just now", RegisterFormal, "ja", justNow, "先ほど"},
+		{"JA Casual just now", RegisterCasual, "ja", justNow, "さっき"},
+		{"JA Neutral", RegisterNeutral, "ja", fiveMinsAgo, "5 分 前"},
+		{"JA Casual", RegisterCasual, "ja", fiveMinsAgo, "5 分前"},
+		{"JA Formal", RegisterFormal, "ja", fiveMinsAgo, "5 分 前"},
+		{"JA Casual future", RegisterCasual, "ja", time.Now().Add(2*time.Hour + time.Minute), "あと 2 時間"},
+		{"EN Formal (no variants)", RegisterFormal, "en", fiveMinsAgo, "5 minutes ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Formatter{Translator: RegistryTranslator{Register: tt.reg}}
+			if got := f.Social(tt.t, tt.lang, StyleStandard); got != tt.expected {
+				t.Errorf("Social() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPseudoLocale(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Just now", Social(now.Add(-5*time.Second), "en-XA", StyleStandard), "[ĵûûšţ ñööŵ]"},
+		{"Past", Social(now.Add(-5*time.Minute), "en-XA", StyleStandard), "[5 [ɱîîñûûţééš] ååĝöö]"},
+		{"Future", Social(now.Add(2*time.Hour+time.Minute), "en-XA", StyleStandard), "[îîñ 2 [ĥööûûŕš]]"},
+		{"Short", Social(now.Add(-5*time.Minute), "en-XA", StyleShort), "[5[ɱ]]"},
+		{"Duration", Duration(100*time.Second, "en-XA"), "[1 [ɱîîñûûţéé] 40 [šééçööñðš]]"},
+		{"Adaptive old date", Adaptive(time.Date(2001, 12, 25, 10, 0, 0, 0, time.UTC), "en-XA"), "[25 [Ðééç] 2001]"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
 func TestAdaptive(t *testing.T) {
 	now := time.Now()
 	
@@ -59,4 +221,10 @@ func TestAdaptive(t *testing.T) {
 	// For simplicity, we assume generic "HH:MM" format check if < 24h
 	
 	// Let's rely on unit logic: < 1 min, < 24h, < 7d
+
+	// Case 3: Older than a year uses localized month names
+	old := time.Date(2001, 12, 25, 10, 0, 0, 0, time.UTC)
+	if got := Adaptive(old, "en"); got != "25 Dec 2001" {
+		t.Errorf("Adaptive(old) = %v, want '25 Dec 2001'", got)
+	}
 }
@@ -3,6 +3,8 @@ package smart
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -38,7 +40,17 @@ const (
 //	fmt.Println(Social(inFiveMinutes, "en", StyleStandard))  // Output: "in 5 minutes"
 //	fmt.Println(Social(fiveMinutesAgo, "en", StyleShort))    // Output: "5m"
 //	fmt.Println(Social(fiveMinutesAgo, "id", StyleStandard)) // Output: "5 menit lalu"
+//	fmt.Println(Social(fiveMinutesAgo, "de", StyleStandard)) // Output: "vor 5 Minuten"
 func Social(t time.Time, lang string, style RelativeStyle) string {
+	return defaultFormatter.Social(t, lang, style)
+}
+
+// Social is like the package-level Social but resolves strings through f's Translator.
+func (f Formatter) Social(t time.Time, lang string, style RelativeStyle) string {
+	return pseudoBracket(lang, f.social(t, lang, style))
+}
+
+func (f Formatter) social(t time.Time, lang string, style RelativeStyle) string {
 	now := time.Now().In(t.Location())
 	diff := now.Sub(t)
 	seconds := math.Abs(diff.Seconds())
@@ -51,7 +63,7 @@ func Social(t time.Time, lang string, style RelativeStyle) string {
 	year := 31536000.0
 
 	if seconds < 10 {
-		return GetTrans(lang, "just_now")
+		return f.trans(lang, "just_now")
 	}
 
 	var val int
@@ -81,14 +93,42 @@ func Social(t time.Time, lang string, style RelativeStyle) string {
 	}
 
 	if style == StyleShort {
-		return fmt.Sprintf("%d%s", val, GetTrans(lang, unitShort))
+		short := f.trans(lang, unitShort)
+		if isPhrasePattern(short) {
+			return applyPattern(short, strconv.Itoa(val))
+		}
+		return fmt.Sprintf("%d%s", val, short)
 	}
 
-	// Use GetPlural for standard style units (e.g. "minute" vs "minutes")
-	term := GetPlural(lang, unit, val)
-
+	// Use plural forms for standard style units (e.g. "minute" vs "minutes"),
+	// in the grammatical context required by the surrounding phrase.
+	ctx, pattern := ContextFuture, "future"
 	if isPast {
-		return fmt.Sprintf("%d %s %s", val, term, GetTrans(lang, "ago"))
+		ctx, pattern = ContextPast, "past"
+	}
+	term := f.plural(lang, unit, val, ctx)
+	if isPhrasePattern(term) {
+		return applyPattern(term, strconv.Itoa(val)) // Full CLDR phrase: "vor {0} Minuten"
+	}
+
+	return applyPattern(f.trans(lang, pattern), quantity(val, term))
+}
+
+// applyPattern substitutes value for the "{0}" placeholder in a locale pattern
+// such as "{0} ago" or "vor {0}".
+func applyPattern(pattern, value string) string {
+	return strings.Replace(pattern, "{0}", value, 1)
+}
+
+// isPhrasePattern reports whether a form is a full "{0}" phrase instead of a bare word.
+func isPhrasePattern(form string) bool {
+	return strings.Contains(form, "{0}")
+}
+
+// quantity joins a number with its unit form: "5 minutes", or "5 минут" from "{0} минут".
+func quantity(n int, form string) string {
+	if isPhrasePattern(form) {
+		return applyPattern(form, strconv.Itoa(n))
 	}
-	return fmt.Sprintf("%s %d %s", GetTrans(lang, "in"), val, term)
+	return fmt.Sprintf("%d %s", n, form)
 }
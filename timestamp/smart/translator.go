@@ -0,0 +1,69 @@
+package smart
+
+import "github.com/Roisfaozi/unik/timestamp/util"
+
+// Translator resolves the localized strings used by the smart formatters.
+// Implement it to serve relative-time strings from an existing message catalog
+// instead of the built-in registry.
+type Translator interface {
+	// Trans returns a static translation (e.g. "just_now", or the "past" and
+	// "future" patterns containing a "{0}" placeholder). See GetTrans.
+	Trans(lang, key string) string
+
+	// Plural returns the form of a unit word (e.g. "min") for count in the
+	// given grammatical context. See GetPluralForm.
+	Plural(lang, key string, count int, ctx GrammaticalContext) string
+}
+
+// RegistryTranslator is the default Translator, backed by the built-in locale registry.
+// Register selects casual or formal wording; locales without a variant for it
+// use their neutral wording.
+type RegistryTranslator struct {
+	Register Register
+}
+
+// Trans implements Translator using GetTrans.
+func (rt RegistryTranslator) Trans(lang, key string) string {
+	return getTrans(lang, key, rt.Register)
+}
+
+// Plural implements Translator using GetPluralForm.
+func (rt RegistryTranslator) Plural(lang, key string, count int, ctx GrammaticalContext) string {
+	return getPluralForm(lang, key, count, ctx, rt.Register)
+}
+
+// Formatter renders relative times, adaptive timestamps and durations through a Translator.
+// The zero value uses RegistryTranslator.
+//
+// Example:
+//
+//	f := Formatter{Translator: myCatalog}
+//	fmt.Println(f.Social(t, "en", StyleStandard))
+type Formatter struct {
+	Translator Translator
+}
+
+var defaultFormatter = Formatter{}
+
+func (f Formatter) trans(lang, key string) string {
+	if f.Translator == nil {
+		return GetTrans(lang, key)
+	}
+	return f.Translator.Trans(lang, key)
+}
+
+func (f Formatter) plural(lang, key string, count int, ctx GrammaticalContext) string {
+	if f.Translator == nil {
+		return GetPluralForm(lang, key, count, ctx)
+	}
+	return f.Translator.Plural(lang, key, count, ctx)
+}
+
+// pseudoBracket wraps the whole of out for the pseudo-locale, as the regional
+// formatters do, so a string cut short or built from pieces stands out.
+func pseudoBracket(lang, out string) string {
+	if util.IsPseudoLocale(lang) {
+		return util.PseudoBracket(out)
+	}
+	return out
+}
@@ -21,7 +21,7 @@ import (
 func Smart(unix int64, opts ...Option) string {
 	cfg := resolveConfig(opts...)
 	t := util.Normalize(UnixToTime(unix), cfg.DefaultTimezone)
-	return smart.Adaptive(t, cfg.Language)
+	return cfg.formatter().Adaptive(t, cfg.lang())
 }
 
 // Social returns a relative time string (e.g., "2 hours ago", "in 5 minutes")
@@ -37,7 +37,7 @@ func Smart(unix int64, opts ...Option) string {
 func Social(unix int64, opts ...Option) string {
 	cfg := resolveConfig(opts...)
 	t := util.Normalize(UnixToTime(unix), cfg.DefaultTimezone)
-	return smart.Social(t, cfg.Language, smart.StyleStandard)
+	return cfg.formatter().Social(t, cfg.lang(), smart.StyleStandard)
 }
 
 // SocialShort returns a compact relative time string (e.g., "2h", "5m")
@@ -53,10 +53,15 @@ func Social(unix int64, opts ...Option) string {
 func SocialShort(unix int64, opts ...Option) string {
 	cfg := resolveConfig(opts...)
 	t := util.Normalize(UnixToTime(unix), cfg.DefaultTimezone)
-	return smart.Social(t, cfg.Language, smart.StyleShort)
+	return cfg.formatter().Social(t, cfg.lang(), smart.StyleShort)
 }
 
 // Regional formats a Unix timestamp into a localized date and time string based on a specified region.
+// WithDateStyle and WithTimeStyle switch from the region's default format to
+// the region's short, medium, long or full patterns.
+// The region sets the layout and WithLanguage the words: without a language the
+// region's own is used, so RegionID shows Indonesian month names unless, say,
+// WithLanguage("en") asks for English ones.
 //
 // Example:
 //
@@ -66,6 +71,9 @@ func SocialShort(unix int64, opts ...Option) string {
 func Regional(unix int64, region regional.Region, opts ...Option) string {
 	cfg := resolveConfig(opts...)
 	t := util.Normalize(UnixToTime(unix), cfg.DefaultTimezone)
+	if cfg.DateStyle != regional.StyleNone || cfg.TimeStyle != regional.StyleNone {
+		return regional.FormatCalendarStyle(t, region, cfg.Language, cfg.DateStyle, cfg.TimeStyle, cfg.Calendar)
+	}
 	return regional.Format(t, region, cfg.Language, cfg.Calendar)
 }
 
@@ -103,6 +111,24 @@ func Custom(unix int64, layout string, opts ...Option) string {
 	return t.Format(layout)
 }
 
+// FormatPattern formats a Unix timestamp with an LDML date pattern, e.g.
+// "EEEE, d MMMM y HH:mm", or a skeleton of field letters such as "yMMMd",
+// which is resolved to the preferred pattern of the region set with WithRegion.
+// Names of months and weekdays follow WithLanguage, and the date follows
+// WithCalendar ("GGGGGy.M.d" gives "R5.12.25" with regional.JapaneseCalendar).
+//
+// Example:
+//
+//	unixTimestamp := time.Date(2023, time.December, 25, 15, 30, 0, 0, time.UTC).Unix()
+//	fmt.Println(FormatPattern(unixTimestamp, "yMMMd"))                                  // Output: Dec 25, 2023
+//	fmt.Println(FormatPattern(unixTimestamp, "yMMMd", WithRegion(regional.RegionEU)))   // Output: 25 Dec 2023
+//	fmt.Println(FormatPattern(unixTimestamp, "EEEE, d MMMM y", WithLanguage("id")))     // Output: Senin, 25 Desember 2023
+func FormatPattern(unix int64, pattern string, opts ...Option) string {
+	cfg := resolveConfig(opts...)
+	t := util.Normalize(UnixToTime(unix), cfg.DefaultTimezone)
+	return regional.FormatCalendarPattern(t, pattern, cfg.Region, cfg.Language, cfg.Calendar)
+}
+
 // ParseRegional parses a date string according to a specific region's format
 // and returns its Unix timestamp.
 //
@@ -148,5 +174,5 @@ func ParseWithLayout(dateStr, layout string) (int64, error) {
 func Duration(seconds int64, opts ...Option) string {
 	cfg := resolveConfig(opts...)
 	d := time.Duration(seconds) * time.Second
-	return smart.Duration(d, cfg.Language)
+	return cfg.formatter().Duration(d, cfg.lang())
 }
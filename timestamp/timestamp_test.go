@@ -1,11 +1,13 @@
 package timestamp_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Roisfaozi/unik/timestamp"
 	"github.com/Roisfaozi/unik/timestamp/regional"
+	"github.com/Roisfaozi/unik/timestamp/smart"
 )
 
 func TestSmart(t *testing.T) {
@@ -41,6 +43,19 @@ func TestRegional(t *testing.T) {
 		{regional.RegionUS, []timestamp.Option{timestamp.WithTimezone("UTC")}, "12/25/2023 03:30 PM"},
 		{regional.RegionEU, []timestamp.Option{timestamp.WithTimezone("UTC")}, "25/12/2023 15:30"},
 		{regional.RegionJP, []timestamp.Option{timestamp.WithTimezone("UTC")}, "2023/12/25"},
+		{regional.RegionID, []timestamp.Option{timestamp.WithTimezone("UTC")}, "25 Desember 2023"},
+		{regional.RegionID, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithLanguage("en")}, "25 December 2023"},
+		{regional.RegionID, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.HijriCalendar{})}, "12 Jumadil Akhir 1445 H"},
+		{regional.RegionIR, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.PersianCalendar{})}, "۴ دی ۱۴۰۲"},
+		{regional.RegionIL, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.HebrewCalendar{})}, "13 בטבת 5784"},
+		{regional.RegionCN, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.ChineseCalendar{})}, "癸卯2023年11月13日"},
+		{regional.RegionID, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.JavaneseCalendar{})}, "11 Jumadilakir 1957 AJ"},
+		{regional.RegionIN, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithLanguage("hi"), timestamp.WithCalendar(regional.SakaCalendar{})}, "4 पौष 1945 शक"},
+		{regional.RegionET, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.EthiopianCalendar{})}, "15 ታኅሣሥ 2016"},
+		{regional.RegionEG, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.CopticCalendar{})}, "15 كيهك 1740 ش"},
+		{regional.RegionEU, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithLanguage("id"), timestamp.WithDateStyle(regional.StyleLong)}, "25 Desember 2023"},
+		{regional.RegionID, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.HijriCalendar{}), timestamp.WithDateStyle(regional.StyleLong)}, "12 Jumadil Akhir 1445 H"},
+		{regional.RegionUS, []timestamp.Option{timestamp.WithTimezone("UTC"), timestamp.WithCalendar(regional.HijriCalendar{}), timestamp.WithDateStyle(regional.StyleFull)}, "Monday, Jumada II 12, 1445 AH"},
 	}
 
 	for _, tt := range tests {
@@ -89,3 +104,120 @@ func TestDuration(t *testing.T) {
 		})
 	}
 }
+
+type upperTranslator struct{}
+
+func (upperTranslator) Trans(lang, key string) string {
+	return strings.ToUpper(smart.GetTrans(lang, key))
+}
+
+func (upperTranslator) Plural(lang, key string, count int, ctx smart.GrammaticalContext) string {
+	return strings.ToUpper(smart.GetPluralForm(lang, key, count, ctx))
+}
+
+func TestWithTranslator(t *testing.T) {
+	fiveMinsAgo := time.Now().Add(-5 * time.Minute).Unix()
+
+	got := timestamp.Social(fiveMinsAgo, timestamp.WithTranslator(upperTranslator{}))
+	if got != "5 MINUTES AGO" {
+		t.Errorf("Social(WithTranslator) = %v, want '5 MINUTES AGO'", got)
+	}
+
+	got = timestamp.Duration(100, timestamp.WithTranslator(upperTranslator{}), timestamp.WithLanguage("id"))
+	if got != "1 MENIT 40 DETIK" {
+		t.Errorf("Duration(WithTranslator) = %v, want '1 MENIT 40 DETIK'", got)
+	}
+}
+
+func TestWithRegister(t *testing.T) {
+	fiveMinsAgo := time.Now().Add(-5 * time.Minute).Unix()
+	id := timestamp.WithLanguage("id")
+
+	if got := timestamp.Social(fiveMinsAgo, id, timestamp.WithRegister(smart.RegisterCasual)); got != "5 mnt lalu" {
+		t.Errorf("Social(casual) = %v, want '5 mnt lalu'", got)
+	}
+	if got := timestamp.Social(fiveMinsAgo, id, timestamp.WithRegister(smart.RegisterFormal)); got != "5 menit yang lalu" {
+		t.Errorf("Social(formal) = %v, want '5 menit yang lalu'", got)
+	}
+	if got := timestamp.Duration(100, id, timestamp.WithRegister(smart.RegisterCasual)); got != "1 mnt 40 dtk" {
+		t.Errorf("Duration(casual) = %v, want '1 mnt 40 dtk'", got)
+	}
+}
+
+func TestPseudoLocale(t *testing.T) {
+	pseudo := timestamp.WithLanguage("en-XA")
+	fiveMinsAgo := time.Now().Add(-5 * time.Minute).Unix()
+	christmas := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC).Unix()
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Social", timestamp.Social(fiveMinsAgo, pseudo), "[5 [ɱîîñûûţééš] ååĝöö]"},
+		{"Smart", timestamp.Smart(christmas, pseudo), "[25 [Ðééç] 2023]"},
+		{"Duration", timestamp.Duration(3600, pseudo), "[1 [ĥööûûŕ]]"},
+		{"Regional", timestamp.Regional(christmas, regional.RegionID, pseudo), "[25 [Ðééçééɱƀééŕ] 2023]"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s(en-XA) = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+func TestFormatPattern(t *testing.T) {
+	unix := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC).Unix()
+	utc := timestamp.WithTimezone("UTC")
+
+	tests := []struct {
+		name     string
+		pattern  string
+		opts     []timestamp.Option
+		expected string
+	}{
+		{"Skeleton default", "yMMMd", nil, "Dec 25, 2023"},
+		{"Skeleton EU", "yMMMd", []timestamp.Option{timestamp.WithRegion(regional.RegionEU)}, "25 Dec 2023"},
+		{"Skeleton by language", "yMMMMd", []timestamp.Option{timestamp.WithLanguage("de")}, "25. Dezember 2023"},
+		{"Pattern ID", "EEEE, d MMMM y", []timestamp.Option{timestamp.WithLanguage("id")}, "Senin, 25 Desember 2023"},
+		{"Pattern with timezone", "d MMM y HH:mm", []timestamp.Option{timestamp.WithTimezone("Asia/Jakarta")}, "25 Dec 2023 22:30"},
+		{"Japanese era short form", "GGGGGy.M.d", []timestamp.Option{timestamp.WithCalendar(regional.JapaneseCalendar{})}, "R5.12.25"},
+		{"Japanese era in kanji", "Gy年M月d日", []timestamp.Option{timestamp.WithLanguage("ja"), timestamp.WithCalendar(regional.JapaneseCalendar{})}, "令和5年12月25日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timestamp.FormatPattern(unix, tt.pattern, append([]timestamp.Option{utc}, tt.opts...)...)
+			if got != tt.expected {
+				t.Errorf("FormatPattern(%q) = %v, want %v", tt.pattern, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegional_Styles(t *testing.T) {
+	unix := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC).Unix()
+	utc := timestamp.WithTimezone("UTC")
+
+	tests := []struct {
+		name     string
+		region   regional.Region
+		opts     []timestamp.Option
+		expected string
+	}{
+		{"EU full date", regional.RegionEU, []timestamp.Option{timestamp.WithDateStyle(regional.StyleFull)}, "Monday, 25 December 2023"},
+		{"US short date and time", regional.RegionUS, []timestamp.Option{timestamp.WithDateStyle(regional.StyleShort), timestamp.WithTimeStyle(regional.StyleShort)}, "12/25/23, 3:30 PM"},
+		{"EU time only", regional.RegionEU, []timestamp.Option{timestamp.WithTimeStyle(regional.StyleMedium)}, "15:30:00"},
+		{"JP long date", regional.RegionJP, []timestamp.Option{timestamp.WithLanguage("ja"), timestamp.WithDateStyle(regional.StyleLong)}, "2023年12月25日(月)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timestamp.Regional(unix, tt.region, append([]timestamp.Option{utc}, tt.opts...)...)
+			if got != tt.expected {
+				t.Errorf("Regional(%s) = %v, want %v", tt.region, got, tt.expected)
+			}
+		})
+	}
+}
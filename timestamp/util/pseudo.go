@@ -0,0 +1,90 @@
+package util
+
+import "strings"
+
+// PseudoLocale is the language code of the accented, lengthened pseudo-locale.
+// Formatting with it makes untranslated (hardcoded) text easy to spot: every
+// translated string comes out as "[Ĵååñûûååŕý]" instead of "January".
+const PseudoLocale = "en-XA"
+
+var pseudoAccents = map[rune]string{
+	'a': "å", 'b': "ƀ", 'c': "ç", 'd': "ð", 'e': "é", 'f': "ƒ", 'g': "ĝ", 'h': "ĥ", 'i': "î",
+	'j': "ĵ", 'k': "ķ", 'l': "ļ", 'm': "ɱ", 'n': "ñ", 'o': "ö", 'p': "þ", 'q': "ǫ", 'r': "ŕ",
+	's': "š", 't': "ţ", 'u': "û", 'v': "ṽ", 'w': "ŵ", 'x': "ẋ", 'y': "ý", 'z': "ž",
+	'A': "Å", 'B': "Ɓ", 'C': "Ç", 'D': "Ð", 'E': "É", 'F': "Ƒ", 'G': "Ĝ", 'H': "Ĥ", 'I': "Î",
+	'J': "Ĵ", 'K': "Ķ", 'L': "Ļ", 'M': "Ṁ", 'N': "Ñ", 'O': "Ö", 'P': "Þ", 'Q': "Ǫ", 'R': "Ŕ",
+	'S': "Š", 'T': "Ţ", 'U': "Û", 'V': "Ṽ", 'W': "Ŵ", 'X': "Ẋ", 'Y': "Ý", 'Z': "Ž",
+}
+
+// IsPseudoLocale reports whether lang selects the pseudo-locale. Like the
+// locale registry, it matches the code exactly: "en-xa" is not en-XA.
+func IsPseudoLocale(lang string) bool {
+	return lang == PseudoLocale
+}
+
+// Pseudolocalize accents every ASCII letter, doubles vowels to simulate the
+// ~30% expansion of real translations, and wraps the result in brackets.
+// Placeholders such as "{0}" are left untouched.
+//
+// Example:
+//
+//	Pseudolocalize("{0} ago") // "[{0} ååĝöö]"
+func Pseudolocalize(s string) string {
+	var b strings.Builder
+	inPlaceholder := false
+	for _, r := range s {
+		switch {
+		case r == '{':
+			inPlaceholder = true
+		case r == '}':
+			inPlaceholder = false
+		case !inPlaceholder:
+			if accented, ok := pseudoAccents[r]; ok {
+				b.WriteString(accented)
+				if strings.ContainsRune("aeiouAEIOU", r) {
+					b.WriteString(accented)
+				}
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return PseudoBracket(b.String())
+}
+
+// PseudoBracket wraps s in the pseudo-locale boundary markers, which reveal
+// truncation and string concatenation in rendered output. A string that is
+// already wrapped as a whole, such as a pseudolocalized pattern, is returned
+// as is, so every formatted string carries exactly one outer pair.
+//
+// Example:
+//
+//	PseudoBracket("25 [Ðééç] 2001")         // "[25 [Ðééç] 2001]"
+//	PseudoBracket("[5 [ɱîîñûûţééš] ååĝöö]") // "[5 [ɱîîñûûţééš] ååĝöö]"
+func PseudoBracket(s string) string {
+	if isBracketed(s) {
+		return s
+	}
+	return "[" + s + "]"
+}
+
+// isBracketed reports whether s is one bracketed span: its opening "[" is
+// closed by its last character.
+func isBracketed(s string) bool {
+	if !strings.HasPrefix(s, "[") {
+		return false
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+	return false
+}
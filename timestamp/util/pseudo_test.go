@@ -0,0 +1,45 @@
+package util
+
+import "testing"
+
+func TestPseudolocalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ago", "[ååĝöö]"},
+		{"{0} ago", "[{0} ååĝöö]"},
+		{"in {0}", "[îîñ {0}]"},
+		{"Dec", "[Ðééç]"},
+		{"", "[]"},
+	}
+
+	for _, tt := range tests {
+		if got := Pseudolocalize(tt.input); got != tt.expected {
+			t.Errorf("Pseudolocalize(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	if !IsPseudoLocale("en-XA") || IsPseudoLocale("en-xa") || IsPseudoLocale("en") {
+		t.Error("IsPseudoLocale() did not match the pseudo-locale code")
+	}
+}
+
+func TestPseudoBracket(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"25 [Ðééç] 2001", "[25 [Ðééç] 2001]"},
+		{"[5 [ɱîîñûûţééš] ååĝöö]", "[5 [ɱîîñûûţééš] ååĝöö]"},
+		{"[1] [2]", "[[1] [2]]"},
+		{"5[ɱ]", "[5[ɱ]]"},
+		{"", "[]"},
+	}
+
+	for _, tt := range tests {
+		if got := PseudoBracket(tt.input); got != tt.expected {
+			t.Errorf("PseudoBracket(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}